@@ -0,0 +1,108 @@
+package algorithm
+
+// Aho-Corasick automaton, finds all the occurrences of several patterns in a single pass over the text
+type AhoCorasick interface {
+	// Matches sorted by end position, then from the longest pattern to the shortest
+	FindAll(text string) []Match
+	FindAllBytes(text []byte) []Match
+}
+
+type Match struct {
+	Pattern    int // index of the pattern in the slice given to NewAhoCorasick
+	Start, End int // text[Start:End] is the pattern
+}
+
+type ahoCorasickNode struct {
+	children map[byte]int
+	fail     int   // longest proper suffix of this node present in the trie
+	output   int   // nearest node on the fail chain ending a pattern, -1 if none
+	patterns []int // patterns ending exactly at this node
+	depth    int
+}
+
+type ahoCorasick struct {
+	nodes []ahoCorasickNode
+}
+
+// The empty patterns are ignored, the duplicated ones are all reported
+func NewAhoCorasick(patterns []string) AhoCorasick {
+	this := &ahoCorasick{}
+	this.nodes = []ahoCorasickNode{this.newNode(0)}
+
+	for p, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		node := 0
+		for i := 0; i < len(pattern); i++ {
+			next, ok := this.nodes[node].children[pattern[i]]
+			if !ok {
+				next = len(this.nodes)
+				this.nodes = append(this.nodes, this.newNode(i+1))
+				this.nodes[node].children[pattern[i]] = next
+			}
+			node = next
+		}
+		this.nodes[node].patterns = append(this.nodes[node].patterns, p)
+	}
+
+	// breadth first: the fail links of the parents are known before the ones of their children
+	queue := []int{0}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for c, child := range this.nodes[parent].children {
+			queue = append(queue, child)
+			if parent == 0 {
+				continue
+			}
+			fail := this.step(this.nodes[parent].fail, c)
+			this.nodes[child].fail = fail
+			if len(this.nodes[fail].patterns) > 0 {
+				this.nodes[child].output = fail
+			} else {
+				this.nodes[child].output = this.nodes[fail].output
+			}
+		}
+	}
+	return this
+}
+
+func (this *ahoCorasick) newNode(depth int) ahoCorasickNode {
+	return ahoCorasickNode{children: map[byte]int{}, output: -1, depth: depth}
+}
+
+// Transition from node on c, following the fail links while the child is missing
+func (this *ahoCorasick) step(node int, c byte) int {
+	for {
+		if next, ok := this.nodes[node].children[c]; ok {
+			return next
+		}
+		if node == 0 {
+			return 0
+		}
+		node = this.nodes[node].fail
+	}
+}
+
+func (this *ahoCorasick) FindAll(text string) []Match {
+	return ahoCorasickFind(this, text)
+}
+
+func (this *ahoCorasick) FindAllBytes(text []byte) []Match {
+	return ahoCorasickFind(this, text)
+}
+
+func ahoCorasickFind[S string | []byte](this *ahoCorasick, text S) []Match {
+	matches := []Match{}
+	node := 0
+	for i := 0; i < len(text); i++ {
+		node = this.step(node, text[i])
+		for out := node; out >= 0; out = this.nodes[out].output {
+			for _, p := range this.nodes[out].patterns {
+				matches = append(matches, Match{Pattern: p, Start: i + 1 - this.nodes[out].depth, End: i + 1})
+			}
+		}
+	}
+	return matches
+}
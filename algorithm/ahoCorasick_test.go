@@ -0,0 +1,66 @@
+package algorithm
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// Every match of every pattern, sorted like AhoCorasick.FindAll
+func naiveMultiFind(text string, patterns []string) []Match {
+	matches := []Match{}
+	for p, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		for _, start := range naiveFindAll([]byte(text), []byte(pattern)) {
+			matches = append(matches, Match{Pattern: p, Start: start, End: start + len(pattern)})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.End != b.End {
+			return a.End < b.End
+		}
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		return a.Pattern < b.Pattern
+	})
+	return matches
+}
+
+func TestAhoCorasick(t *testing.T) {
+	ac := NewAhoCorasick([]string{"he", "she", "his", "hers", ""})
+	expected := []Match{
+		{Pattern: 1, Start: 1, End: 4},
+		{Pattern: 0, Start: 2, End: 4},
+		{Pattern: 3, Start: 2, End: 6},
+	}
+	if matches := ac.FindAll("ushers"); !SliceEqual_(matches, expected) {
+		t.Fatalf("FindAll: expected %v got %v", expected, matches)
+	}
+	if matches := ac.FindAllBytes([]byte("ushers")); !SliceEqual_(matches, expected) {
+		t.Fatalf("FindAllBytes: expected %v got %v", expected, matches)
+	}
+	if matches := ac.FindAll("xyz"); len(matches) != 0 {
+		t.Fatalf("FindAll: expected no match got %v", matches)
+	}
+}
+
+func TestAhoCorasickRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for round := 0; round < 1000; round++ {
+		alphabet := "abcd"[:1+r.Intn(4)]
+		patterns := make([]string, r.Intn(8))
+		for i := range patterns {
+			patterns[i] = string(randomBytes(r, r.Intn(5), alphabet))
+		}
+		text := string(randomBytes(r, r.Intn(80), alphabet))
+
+		expected := naiveMultiFind(text, patterns)
+		if matches := NewAhoCorasick(patterns).FindAll(text); !SliceEqual_(matches, expected) {
+			t.Fatalf("FindAll(%q, %q): expected %v got %v", text, patterns, expected, matches)
+		}
+	}
+}
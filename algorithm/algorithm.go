@@ -12,6 +12,7 @@ func Min[T Ord](a, b T) T {
 	}
 }
 
+// Panics if xs is empty, see MinBy for a safe version
 func Min_[T Ord](xs ...T) T {
 	min := xs[0]
 	for i := 1; i < len(xs); i++ {
@@ -30,6 +31,7 @@ func Max[T Ord](a, b T) T {
 	}
 }
 
+// Panics if xs is empty, see MaxBy for a safe version
 func Max_[T Ord](xs ...T) T {
 	max := xs[0]
 	for i := 1; i < len(xs); i++ {
@@ -40,8 +42,9 @@ func Max_[T Ord](xs ...T) T {
 	return max
 }
 
+// Not stable, comp must be a strict weak ordering (e.g. Less)
 func Sort[T any](slice []T, comp Ordf[T]) {
-	QuickSort(slice, comp, 0, len(slice)-1)
+	pdqsort(slice, comp, 0, len(slice), sortLimit(len(slice)))
 }
 
 func QuickSort[T any](slice []T, comp Ordf[T], low, high int) {
@@ -72,28 +75,13 @@ func Partition[T any](slice []T, comp Ordf[T], low, high int) int {
 	return i + 1
 }
 
+// True if a and b have the same length and comp(a[i], b[i]) for every i
 func SliceEqual[T any](a, b []T, comp Compf[T]) bool {
-	if len(a) != len(b) {
-		return false
-	}
-	for i := range a {
-		if comp(a[i], b[i]) {
-			return false
-		}
-	}
-	return true
+	return len(a) == len(b) && MismatchFunc(a, b, comp) == -1
 }
 
 func SliceEqual_[T comparable](a, b []T) bool {
-	if len(a) != len(b) {
-		return false
-	}
-	for i := range a {
-		if a[i] == b[i] {
-			return false
-		}
-	}
-	return true
+	return len(a) == len(b) && Mismatch(a, b) == -1
 }
 
 func All[T any](slice []T, comp Eqf[T]) bool {
@@ -11,7 +11,7 @@ func TestSort(t *testing.T) {
 	Sort(sliceInt, Less[int])
 
 	intResult := []int{-5, 0, 4, 12, 20, 42, 69}
-	if SliceEqual(sliceInt, intResult, Equal[int]) {
+	if !SliceEqual(sliceInt, intResult, Equal[int]) {
 		t.Fatalf("expected %v got %v", intResult, sliceInt)
 	}
 }
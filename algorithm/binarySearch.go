@@ -0,0 +1,78 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// The slice must be partitioned by pred: all the elements satisfying pred come first
+// Returns the index of the first element not satisfying pred
+func PartitionPoint[T any](slice []T, pred Eqf[T]) int {
+	low, high := 0, len(slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if pred(slice[mid]) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// Index of the first element not less than value
+func LowerBound[T any](slice []T, value T, comp Ordf[T]) int {
+	return PartitionPoint(slice, func(a T) bool { return comp(a, value) })
+}
+
+func LowerBound_[T Ord](slice []T, value T) int {
+	return LowerBound(slice, value, Less[T])
+}
+
+func LowerBoundByKey[T any, K Ord](slice []T, key K, keyf func(T) K) int {
+	return PartitionPoint(slice, func(a T) bool { return keyf(a) < key })
+}
+
+// Index of the first element greater than value
+func UpperBound[T any](slice []T, value T, comp Ordf[T]) int {
+	return PartitionPoint(slice, func(a T) bool { return !comp(value, a) })
+}
+
+func UpperBound_[T Ord](slice []T, value T) int {
+	return UpperBound(slice, value, Less[T])
+}
+
+func UpperBoundByKey[T any, K Ord](slice []T, key K, keyf func(T) K) int {
+	return PartitionPoint(slice, func(a T) bool { return !(key < keyf(a)) })
+}
+
+// slice[low:high] contains all the elements equal to value
+func EqualRange[T any](slice []T, value T, comp Ordf[T]) (low, high int) {
+	low = LowerBound(slice, value, comp)
+	high = low + UpperBound(slice[low:], value, comp)
+	return low, high
+}
+
+func EqualRange_[T Ord](slice []T, value T) (low, high int) {
+	return EqualRange(slice, value, Less[T])
+}
+
+func EqualRangeByKey[T any, K Ord](slice []T, key K, keyf func(T) K) (low, high int) {
+	low = LowerBoundByKey(slice, key, keyf)
+	high = low + UpperBoundByKey(slice[low:], key, keyf)
+	return low, high
+}
+
+// Returns the position of value if found, or where it should be inserted
+func BinarySearch[T any](slice []T, value T, comp Ordf[T]) (int, bool) {
+	i := LowerBound(slice, value, comp)
+	return i, i < len(slice) && !comp(value, slice[i])
+}
+
+func BinarySearch_[T Ord](slice []T, value T) (int, bool) {
+	return BinarySearch(slice, value, Less[T])
+}
+
+func BinarySearchByKey[T any, K Ord](slice []T, key K, keyf func(T) K) (int, bool) {
+	i := LowerBoundByKey(slice, key, keyf)
+	return i, i < len(slice) && !(key < keyf(slice[i]))
+}
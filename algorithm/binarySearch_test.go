@@ -0,0 +1,94 @@
+package algorithm
+
+import (
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestBounds(t *testing.T) {
+	slice := []int{1, 2, 2, 2, 5, 7, 7, 9}
+	tests := []struct {
+		value        int
+		lower, upper int
+		found        bool
+	}{
+		{0, 0, 0, false},
+		{1, 0, 1, true},
+		{2, 1, 4, true},
+		{3, 4, 4, false},
+		{7, 5, 7, true},
+		{9, 7, 8, true},
+		{10, 8, 8, false},
+	}
+
+	for _, test := range tests {
+		if lower := LowerBound(slice, test.value, Less[int]); lower != test.lower {
+			t.Fatalf("LowerBound(%d): expected %d got %d", test.value, test.lower, lower)
+		}
+		if lower := LowerBound_(slice, test.value); lower != test.lower {
+			t.Fatalf("LowerBound_(%d): expected %d got %d", test.value, test.lower, lower)
+		}
+		if upper := UpperBound(slice, test.value, Less[int]); upper != test.upper {
+			t.Fatalf("UpperBound(%d): expected %d got %d", test.value, test.upper, upper)
+		}
+		if upper := UpperBound_(slice, test.value); upper != test.upper {
+			t.Fatalf("UpperBound_(%d): expected %d got %d", test.value, test.upper, upper)
+		}
+		if lower, upper := EqualRange_(slice, test.value); lower != test.lower || upper != test.upper {
+			t.Fatalf("EqualRange_(%d): expected [%d, %d) got [%d, %d)", test.value, test.lower, test.upper, lower, upper)
+		}
+		if i, found := BinarySearch_(slice, test.value); i != test.lower || found != test.found {
+			t.Fatalf("BinarySearch_(%d): expected (%d, %v) got (%d, %v)", test.value, test.lower, test.found, i, found)
+		}
+	}
+
+	if i := LowerBound([]int{}, 42, Less[int]); i != 0 {
+		t.Fatalf("LowerBound on empty slice: expected %d got %d", 0, i)
+	}
+}
+
+func TestBoundsCustomOrder(t *testing.T) {
+	slice := []int{9, 7, 7, 5, 2}
+	if lower, upper := EqualRange(slice, 7, Greater[int]); lower != 1 || upper != 3 {
+		t.Fatalf("EqualRange: expected [%d, %d) got [%d, %d)", 1, 3, lower, upper)
+	}
+	if i, found := BinarySearch(slice, 3, Greater[int]); i != 4 || found {
+		t.Fatalf("BinarySearch: expected (%d, %v) got (%d, %v)", 4, false, i, found)
+	}
+}
+
+func TestBoundsByKey(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"a", 18}, {"b", 21}, {"c", 21}, {"d", 30}}
+	age := func(u user) int { return u.age }
+
+	if i := LowerBoundByKey(users, 21, age); i != 1 {
+		t.Fatalf("LowerBoundByKey: expected %d got %d", 1, i)
+	}
+	if i := UpperBoundByKey(users, 21, age); i != 3 {
+		t.Fatalf("UpperBoundByKey: expected %d got %d", 3, i)
+	}
+	if lower, upper := EqualRangeByKey(users, 21, age); lower != 1 || upper != 3 {
+		t.Fatalf("EqualRangeByKey: expected [%d, %d) got [%d, %d)", 1, 3, lower, upper)
+	}
+	if i, found := BinarySearchByKey(users, 30, age); i != 3 || !found {
+		t.Fatalf("BinarySearchByKey: expected (%d, %v) got (%d, %v)", 3, true, i, found)
+	}
+	if i, found := BinarySearchByKey(users, 25, age); i != 3 || found {
+		t.Fatalf("BinarySearchByKey: expected (%d, %v) got (%d, %v)", 3, false, i, found)
+	}
+}
+
+func TestPartitionPoint(t *testing.T) {
+	slice := []int{2, 4, 6, 1, 3}
+	if i := PartitionPoint(slice, func(a int) bool { return a%2 == 0 }); i != 3 {
+		t.Fatalf("PartitionPoint: expected %d got %d", 3, i)
+	}
+	if i := PartitionPoint(slice, func(a int) bool { return true }); i != len(slice) {
+		t.Fatalf("PartitionPoint: expected %d got %d", len(slice), i)
+	}
+}
@@ -0,0 +1,141 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// The returned sub-slices share the memory of the input, their capacity is limited to their
+// length so appending to one of them does not overwrite the next elements
+// The Iter variants yield the same sub-slices lazily
+
+// Consecutive sub-slices of n elements, the last one may be shorter. nil on n <= 0
+func Chunk[T any](slice []T, n int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+	chunks := make([][]T, 0, (len(slice)+n-1)/n)
+	ChunkIter(slice, n)(func(chunk []T) bool {
+		chunks = append(chunks, chunk)
+		return true
+	})
+	return chunks
+}
+
+func ChunkIter[T any](slice []T, n int) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		if n <= 0 {
+			return
+		}
+		for low := 0; low < len(slice); low += n {
+			high := Min(low+n, len(slice))
+			if !yield(slice[low:high:high]) {
+				return
+			}
+		}
+	}
+}
+
+// Sliding windows of size elements, starting every step elements
+// Only full windows are returned. nil on size <= 0 or step <= 0
+func Window[T any](slice []T, size, step int) [][]T {
+	var windows [][]T
+	WindowIter(slice, size, step)(func(window []T) bool {
+		windows = append(windows, window)
+		return true
+	})
+	return windows
+}
+
+func WindowIter[T any](slice []T, size, step int) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		if size <= 0 || step <= 0 {
+			return
+		}
+		for low := 0; low+size <= len(slice); low += step {
+			if !yield(slice[low : low+size : low+size]) {
+				return
+			}
+		}
+	}
+}
+
+// Pairs of elements at the same index, stops at the end of the shortest slice
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	pairs := make([]Pair[A, B], Min(len(a), len(b)))
+	for i := range pairs {
+		pairs[i] = MakePair(a[i], b[i])
+	}
+	return pairs
+}
+
+func ZipIter[A, B any](a []A, b []B) func(yield func(A, B) bool) {
+	return func(yield func(A, B) bool) {
+		for i, n := 0, Min(len(a), len(b)); i < n; i++ {
+			if !yield(a[i], b[i]) {
+				return
+			}
+		}
+	}
+}
+
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, pair := range pairs {
+		a[i], b[i] = pair.First, pair.Second
+	}
+	return a, b
+}
+
+// Round robin over the slices: a[0], b[0], c[0], a[1]... the exhausted slices are skipped
+func Interleave[T any](slices ...[]T) []T {
+	result := make([]T, 0, totalLen(slices))
+	InterleaveIter(slices...)(func(a T) bool {
+		result = append(result, a)
+		return true
+	})
+	return result
+}
+
+func InterleaveIter[T any](slices ...[]T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for i, remaining := 0, true; remaining; i++ {
+			remaining = false
+			for _, slice := range slices {
+				if i < len(slice) {
+					remaining = true
+					if !yield(slice[i]) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func Flatten[T any](slices [][]T) []T {
+	result := make([]T, 0, totalLen(slices))
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+	return result
+}
+
+func FlattenIter[T any](slices [][]T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, slice := range slices {
+			for _, a := range slice {
+				if !yield(a) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func totalLen[T any](slices [][]T) int {
+	size := 0
+	for _, slice := range slices {
+		size += len(slice)
+	}
+	return size
+}
@@ -0,0 +1,110 @@
+package algorithm
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		slice    []int
+		n        int
+		expected string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, "[[1 2] [3 4] [5]]"},
+		{[]int{1, 2, 3, 4}, 2, "[[1 2] [3 4]]"},
+		{[]int{1, 2}, 5, "[[1 2]]"},
+		{[]int{}, 2, "[]"},
+		{[]int{1, 2}, 0, "[]"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprint(Chunk(test.slice, test.n)); result != test.expected {
+			t.Fatalf("Chunk(%v, %d): expected %s got %s", test.slice, test.n, test.expected, result)
+		}
+		if result := fmt.Sprint(collect(ChunkIter(test.slice, test.n))); result != test.expected {
+			t.Fatalf("ChunkIter(%v, %d): expected %s got %s", test.slice, test.n, test.expected, result)
+		}
+	}
+
+	// the chunks are views, appending to one must not overwrite the next one
+	slice := []int{1, 2, 3, 4}
+	chunks := Chunk(slice, 2)
+	chunks[0][0] = 42
+	_ = append(chunks[0], 0)
+	if !SliceEqual_(slice, []int{42, 2, 3, 4}) {
+		t.Fatalf("expected %v got %v", []int{42, 2, 3, 4}, slice)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		slice      []int
+		size, step int
+		expected   string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 3, 1, "[[1 2 3] [2 3 4] [3 4 5]]"},
+		{[]int{1, 2, 3, 4, 5}, 2, 2, "[[1 2] [3 4]]"},
+		{[]int{1, 2, 3, 4, 5}, 1, 3, "[[1] [4]]"},
+		{[]int{1, 2}, 3, 1, "[]"},
+		{[]int{1, 2}, 0, 1, "[]"},
+		{[]int{1, 2}, 1, 0, "[]"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprint(Window(test.slice, test.size, test.step)); result != test.expected {
+			t.Fatalf("Window(%v, %d, %d): expected %s got %s", test.slice, test.size, test.step, test.expected, result)
+		}
+		if result := fmt.Sprint(collect(WindowIter(test.slice, test.size, test.step))); result != test.expected {
+			t.Fatalf("WindowIter(%v, %d, %d): expected %s got %s", test.slice, test.size, test.step, test.expected, result)
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	pairs := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	expected := []Pair[int, string]{MakePair(1, "a"), MakePair(2, "b")}
+	if !SliceEqual_(pairs, expected) {
+		t.Fatalf("Zip: expected %v got %v", expected, pairs)
+	}
+
+	a, b := Unzip(pairs)
+	if !SliceEqual_(a, []int{1, 2}) || !SliceEqual_(b, []string{"a", "b"}) {
+		t.Fatalf("Unzip: expected %v %v got %v %v", []int{1, 2}, []string{"a", "b"}, a, b)
+	}
+
+	keys := []int{}
+	ZipIter([]int{1, 2, 3}, []string{"a", "b", "c"})(func(i int, s string) bool {
+		keys = append(keys, i)
+		return i < 2
+	})
+	if !SliceEqual_(keys, []int{1, 2}) {
+		t.Fatalf("ZipIter: expected %v got %v", []int{1, 2}, keys)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	result := Interleave([]int{1, 4, 6}, []int{}, []int{2, 5}, []int{3})
+	if expected := []int{1, 2, 3, 4, 5, 6}; !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := Interleave[int](); len(result) != 0 {
+		t.Fatalf("expected an empty slice got %v", result)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	slices := [][]int{{1, 2}, {}, {3}, {4, 5}}
+	if result := Flatten(slices); !SliceEqual_(result, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Flatten: expected %v got %v", []int{1, 2, 3, 4, 5}, result)
+	}
+
+	result := []int{}
+	FlattenIter(slices)(func(a int) bool {
+		result = append(result, a)
+		return a < 3
+	})
+	if !SliceEqual_(result, []int{1, 2, 3}) {
+		t.Fatalf("FlattenIter: expected %v got %v", []int{1, 2, 3}, result)
+	}
+}
@@ -0,0 +1,78 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Index of the first difference between a and b, -1 if they are equal
+// If one is a prefix of the other, returns the length of the shortest one
+func Mismatch[T comparable](a, b []T) int {
+	return MismatchFunc(a, b, Equal[T])
+}
+
+func MismatchFunc[T any](a, b []T, comp Compf[T]) int {
+	n := Min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if !comp(a[i], b[i]) {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return n
+	}
+	return -1
+}
+
+// Returns -1 if a < b, 0 if a == b, 1 if a > b; a prefix is less than the whole slice
+func LexicographicalCompare[T Ord](a, b []T) int {
+	return LexicographicalCompareFunc(a, b, Less[T])
+}
+
+func LexicographicalCompareFunc[T any](a, b []T, comp Ordf[T]) int {
+	n := Min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if comp(a[i], b[i]) {
+			return -1
+		}
+		if comp(b[i], a[i]) {
+			return 1
+		}
+	}
+	if len(a) < len(b) {
+		return -1
+	} else if len(a) > len(b) {
+		return 1
+	}
+	return 0
+}
+
+func StartsWith[T comparable](slice, prefix []T) bool {
+	return StartsWithFunc(slice, prefix, Equal[T])
+}
+
+func StartsWithFunc[T any](slice, prefix []T, comp Compf[T]) bool {
+	return len(prefix) <= len(slice) && SliceEqual(slice[:len(prefix)], prefix, comp)
+}
+
+func EndsWith[T comparable](slice, suffix []T) bool {
+	return EndsWithFunc(slice, suffix, Equal[T])
+}
+
+func EndsWithFunc[T any](slice, suffix []T, comp Compf[T]) bool {
+	return len(suffix) <= len(slice) && SliceEqual(slice[len(slice)-len(suffix):], suffix, comp)
+}
+
+// Index of the first occurrence of sub in slice, -1 if not found
+// An empty sub is found at index 0
+func Search[T comparable](slice, sub []T) int {
+	return SearchFunc(slice, sub, Equal[T])
+}
+
+func SearchFunc[T any](slice, sub []T, comp Compf[T]) int {
+	for i := 0; i+len(sub) <= len(slice); i++ {
+		if SliceEqual(slice[i:i+len(sub)], sub, comp) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,119 @@
+package algorithm
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestSliceEqual(t *testing.T) {
+	tests := []struct {
+		a, b     []int
+		expected bool
+		mismatch int
+	}{
+		{[]int{}, []int{}, true, -1},
+		{nil, []int{}, true, -1},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true, -1},
+		{[]int{1, 2, 3}, []int{1, 2, 4}, false, 2},
+		{[]int{1, 2, 3}, []int{0, 2, 3}, false, 0},
+		{[]int{1, 2}, []int{1, 2, 3}, false, 2},
+		{[]int{1, 2, 3}, []int{1}, false, 1},
+	}
+
+	for _, test := range tests {
+		if result := SliceEqual_(test.a, test.b); result != test.expected {
+			t.Fatalf("SliceEqual_(%v, %v): expected %v got %v", test.a, test.b, test.expected, result)
+		}
+		if result := SliceEqual(test.a, test.b, Equal[int]); result != test.expected {
+			t.Fatalf("SliceEqual(%v, %v): expected %v got %v", test.a, test.b, test.expected, result)
+		}
+		if result := Mismatch(test.a, test.b); result != test.mismatch {
+			t.Fatalf("Mismatch(%v, %v): expected %d got %d", test.a, test.b, test.mismatch, result)
+		}
+	}
+
+	equalFold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	if !SliceEqual([]string{"a", "B"}, []string{"A", "b"}, equalFold) {
+		t.Fatalf("SliceEqual: expected %v got %v", true, false)
+	}
+	if i := MismatchFunc([]string{"a", "B"}, []string{"A", "c"}, equalFold); i != 1 {
+		t.Fatalf("MismatchFunc: expected %d got %d", 1, i)
+	}
+}
+
+func TestLexicographicalCompare(t *testing.T) {
+	tests := []struct {
+		a, b     []int
+		expected int
+	}{
+		{[]int{}, []int{}, 0},
+		{[]int{}, []int{1}, -1},
+		{[]int{1}, []int{}, 1},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, []int{1, 3}, -1},
+		{[]int{1, 3}, []int{1, 2, 3}, 1},
+		{[]int{1, 2}, []int{1, 2, 0}, -1},
+	}
+
+	for _, test := range tests {
+		if result := LexicographicalCompare(test.a, test.b); result != test.expected {
+			t.Fatalf("LexicographicalCompare(%v, %v): expected %d got %d", test.a, test.b, test.expected, result)
+		}
+		if result := LexicographicalCompareFunc(test.a, test.b, Less[int]); result != test.expected {
+			t.Fatalf("LexicographicalCompareFunc(%v, %v): expected %d got %d", test.a, test.b, test.expected, result)
+		}
+	}
+
+	if result := LexicographicalCompareFunc([]int{1, 3}, []int{1, 2}, Greater[int]); result != -1 {
+		t.Fatalf("LexicographicalCompareFunc with Greater: expected %d got %d", -1, result)
+	}
+}
+
+func TestStartsEndsWith(t *testing.T) {
+	tests := []struct {
+		slice, sub   []int
+		starts, ends bool
+	}{
+		{[]int{1, 2, 3}, []int{}, true, true},
+		{[]int{1, 2, 3}, []int{1, 2}, true, false},
+		{[]int{1, 2, 3}, []int{2, 3}, false, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3, 4}, false, false},
+		{[]int{}, []int{1}, false, false},
+	}
+
+	for _, test := range tests {
+		if result := StartsWith(test.slice, test.sub); result != test.starts {
+			t.Fatalf("StartsWith(%v, %v): expected %v got %v", test.slice, test.sub, test.starts, result)
+		}
+		if result := EndsWith(test.slice, test.sub); result != test.ends {
+			t.Fatalf("EndsWith(%v, %v): expected %v got %v", test.slice, test.sub, test.ends, result)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		slice, sub []int
+		expected   int
+	}{
+		{[]int{1, 2, 3}, []int{}, 0},
+		{[]int{}, []int{}, 0},
+		{[]int{}, []int{1}, -1},
+		{[]int{1, 2, 1, 2, 3}, []int{1, 2, 3}, 2},
+		{[]int{1, 2, 1, 2, 3}, []int{2, 1}, 1},
+		{[]int{1, 2, 1, 2, 3}, []int{3, 1}, -1},
+		{[]int{1, 2}, []int{1, 2, 3}, -1},
+	}
+
+	for _, test := range tests {
+		if result := Search(test.slice, test.sub); result != test.expected {
+			t.Fatalf("Search(%v, %v): expected %d got %d", test.slice, test.sub, test.expected, result)
+		}
+		if result := SearchFunc(test.slice, test.sub, Equal[int]); result != test.expected {
+			t.Fatalf("SearchFunc(%v, %v): expected %d got %d", test.slice, test.sub, test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// The functions below work in place: they return the shortened slice, the kept elements keep
+// their relative order and the removed tail of the input is zeroed to release the references
+
+// Remove the consecutive duplicates (like C++ std::unique)
+func Dedup[T comparable](slice []T) []T {
+	return DedupBy(slice, Equal[T])
+}
+
+// Remove the elements equal (according to comp) to the previous kept element
+func DedupBy[T any](slice []T, comp Compf[T]) []T {
+	if len(slice) == 0 {
+		return slice
+	}
+	n := 1
+	for i := 1; i < len(slice); i++ {
+		if !comp(slice[n-1], slice[i]) {
+			slice[n] = slice[i]
+			n++
+		}
+	}
+	return clearTail(slice, n)
+}
+
+// Remove all the duplicates, only the first occurrence of each value is kept
+func DistinctStable[T comparable](slice []T) []T {
+	return DistinctBy(slice, func(a T) T { return a })
+}
+
+// Remove the elements whose key has already been seen
+func DistinctBy[T any, K comparable](slice []T, keyf func(T) K) []T {
+	seen := make(map[K]bool, len(slice))
+	n := 0
+	for _, a := range slice {
+		key := keyf(a)
+		if !seen[key] {
+			seen[key] = true
+			slice[n] = a
+			n++
+		}
+	}
+	return clearTail(slice, n)
+}
+
+func clearTail[T any](slice []T, n int) []T {
+	var zero T
+	for i := n; i < len(slice); i++ {
+		slice[i] = zero
+	}
+	return slice[:n]
+}
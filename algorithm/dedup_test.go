@@ -0,0 +1,72 @@
+package algorithm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		slice, expected []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{1, 1, 2, 3, 3, 1, 1}, []int{1, 2, 3, 1}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		input := append([]int{}, test.slice...)
+		result := Dedup(input)
+		if !SliceEqual_(result, test.expected) {
+			t.Fatalf("Dedup(%v): expected %v got %v", test.slice, test.expected, result)
+		}
+		for _, a := range input[len(result):] {
+			if a != 0 {
+				t.Fatalf("Dedup(%v): the tail has not been zeroed %v", test.slice, input)
+			}
+		}
+	}
+}
+
+func TestDedupBy(t *testing.T) {
+	slice := []string{"a", "A", "b", "B", "b", "a"}
+	result := DedupBy(slice, strings.EqualFold)
+	expected := []string{"a", "b", "a"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+
+	// each element is compared with the last kept one, not with its predecessor
+	ints := []int{1, 2, 3, 4, 5, 6}
+	closeTo := func(a, b int) bool { return b-a <= 2 }
+	if result := DedupBy(ints, closeTo); !SliceEqual_(result, []int{1, 4}) {
+		t.Fatalf("expected %v got %v", []int{1, 4}, result)
+	}
+}
+
+func TestDistinctStable(t *testing.T) {
+	slice := []int{3, 1, 3, 2, 1, 4, 2}
+	result := DistinctStable(slice)
+	expected := []int{3, 1, 2, 4}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := DistinctStable([]int{}); len(result) != 0 {
+		t.Fatalf("expected an empty slice got %v", result)
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	type user struct {
+		name string
+		team string
+	}
+	users := []user{{"a", "red"}, {"b", "blue"}, {"c", "red"}, {"d", "green"}, {"e", "blue"}}
+	result := DistinctBy(users, func(u user) string { return u.team })
+	expected := []user{{"a", "red"}, {"b", "blue"}, {"d", "green"}}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+}
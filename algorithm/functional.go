@@ -0,0 +1,135 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func Map[T, U any](slice []T, f func(T) U) []U {
+	result := make([]U, len(slice))
+	for i, a := range slice {
+		result[i] = f(a)
+	}
+	return result
+}
+
+// Returns a new slice, the input is left unchanged
+func Filter[T any](slice []T, pred Eqf[T]) []T {
+	var result []T
+	for _, a := range slice {
+		if pred(a) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// Left fold: f(...f(f(init, slice[0]), slice[1])..., slice[n-1])
+func Reduce[T, U any](slice []T, init U, f func(U, T) U) U {
+	acc := init
+	for _, a := range slice {
+		acc = f(acc, a)
+	}
+	return acc
+}
+
+// Right fold: f(slice[0], f(slice[1], ...f(slice[n-1], init)))
+func FoldRight[T, U any](slice []T, init U, f func(T, U) U) U {
+	acc := init
+	for i := len(slice) - 1; i >= 0; i-- {
+		acc = f(slice[i], acc)
+	}
+	return acc
+}
+
+func FlatMap[T, U any](slice []T, f func(T) []U) []U {
+	var result []U
+	for _, a := range slice {
+		result = append(result, f(a)...)
+	}
+	return result
+}
+
+// Split the slice in runs of adjacent equal elements
+// The groups share the memory of the input slice
+func GroupBy[T any](slice []T, comp Compf[T]) [][]T {
+	var groups [][]T
+	for low := 0; low < len(slice); {
+		high := low + 1
+		for high < len(slice) && comp(slice[high-1], slice[high]) {
+			high++
+		}
+		groups = append(groups, slice[low:high:high])
+		low = high
+	}
+	return groups
+}
+
+// The order of the elements is preserved inside each group
+func GroupByKey[T any, K comparable](slice []T, keyf func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, a := range slice {
+		key := keyf(a)
+		groups[key] = append(groups[key], a)
+	}
+	return groups
+}
+
+// Returns the elements satisfying pred and the others, both in their original order
+func PartitionBy[T any](slice []T, pred Eqf[T]) (matching, nonMatching []T) {
+	for _, a := range slice {
+		if pred(a) {
+			matching = append(matching, a)
+		} else {
+			nonMatching = append(nonMatching, a)
+		}
+	}
+	return matching, nonMatching
+}
+
+func CountIf[T any](slice []T, pred Eqf[T]) int {
+	count := 0
+	for _, a := range slice {
+		if pred(a) {
+			count++
+		}
+	}
+	return count
+}
+
+func FindIf[T any](slice []T, pred Eqf[T]) (T, bool) {
+	if i := FindIndex(slice, pred); i >= 0 {
+		return slice[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// Returns -1 if no element satisfies pred
+func FindIndex[T any](slice []T, pred Eqf[T]) int {
+	for i, a := range slice {
+		if pred(a) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Longest prefix satisfying pred, shares the memory of the input slice
+// Its capacity is capped: appending to it does not overwrite the rest of the input
+func TakeWhile[T any](slice []T, pred Eqf[T]) []T {
+	i := dropIndex(slice, pred)
+	return slice[:i:i]
+}
+
+// Slice without its longest prefix satisfying pred, shares the memory of the input slice
+func DropWhile[T any](slice []T, pred Eqf[T]) []T {
+	return slice[dropIndex(slice, pred):]
+}
+
+func dropIndex[T any](slice []T, pred Eqf[T]) int {
+	i := 0
+	for i < len(slice) && pred(slice[i]) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,152 @@
+package algorithm
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func isEven(i int) bool { return i%2 == 0 }
+
+func TestMap(t *testing.T) {
+	result := Map([]int{1, 2, 3}, strconv.Itoa)
+	expected := []string{"1", "2", "3"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := Map([]int{}, strconv.Itoa); len(result) != 0 {
+		t.Fatalf("expected an empty slice got %v", result)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6}
+	result := Filter(slice, isEven)
+	expected := []int{2, 4, 6}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if !SliceEqual_(slice, []int{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("the input has been modified: %v", slice)
+	}
+}
+
+func TestFolds(t *testing.T) {
+	slice := []string{"a", "b", "c"}
+
+	left := Reduce(slice, ">", func(acc string, s string) string { return "(" + acc + s + ")" })
+	if left != "(((>a)b)c)" {
+		t.Fatalf("Reduce: expected %s got %s", "(((>a)b)c)", left)
+	}
+	right := FoldRight(slice, "<", func(s string, acc string) string { return "(" + s + acc + ")" })
+	if right != "(a(b(c<)))" {
+		t.Fatalf("FoldRight: expected %s got %s", "(a(b(c<)))", right)
+	}
+
+	sum := Reduce([]int{1, 2, 3, 4}, 0, func(acc, i int) int { return acc + i })
+	if sum != 10 {
+		t.Fatalf("Reduce: expected %d got %d", 10, sum)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	result := FlatMap([]int{1, 2, 3}, func(i int) []int {
+		r := []int{}
+		for j := 0; j < i; j++ {
+			r = append(r, i)
+		}
+		return r
+	})
+	expected := []int{1, 2, 2, 3, 3, 3}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	slice := []int{1, 1, 2, 3, 3, 3, 1}
+	groups := GroupBy(slice, Equal[int])
+	expected := [][]int{{1, 1}, {2}, {3, 3, 3}, {1}}
+	if len(groups) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, groups)
+	}
+	for i := range groups {
+		if !SliceEqual_(groups[i], expected[i]) {
+			t.Fatalf("expected %v got %v", expected, groups)
+		}
+	}
+
+	// appending to a group must not overwrite the next one
+	groups[0] = append(groups[0], 42)
+	if !SliceEqual_(slice, []int{1, 1, 2, 3, 3, 3, 1}) {
+		t.Fatalf("the input has been modified: %v", slice)
+	}
+
+	if groups := GroupBy([]int{}, Equal[int]); len(groups) != 0 {
+		t.Fatalf("expected no group got %v", groups)
+	}
+}
+
+func TestGroupByKey(t *testing.T) {
+	groups := GroupByKey([]string{"a", "bb", "c", "dd", "eee"}, func(s string) int { return len(s) })
+	expected := map[int][]string{1: {"a", "c"}, 2: {"bb", "dd"}, 3: {"eee"}}
+	if len(groups) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, groups)
+	}
+	for key := range expected {
+		if !SliceEqual_(groups[key], expected[key]) {
+			t.Fatalf("expected %v got %v", expected, groups)
+		}
+	}
+}
+
+func TestPartitionBy(t *testing.T) {
+	matching, nonMatching := PartitionBy([]int{1, 2, 3, 4, 5}, isEven)
+	if !SliceEqual_(matching, []int{2, 4}) || !SliceEqual_(nonMatching, []int{1, 3, 5}) {
+		t.Fatalf("expected %v %v got %v %v", []int{2, 4}, []int{1, 3, 5}, matching, nonMatching)
+	}
+}
+
+func TestCountIf(t *testing.T) {
+	if count := CountIf([]int{1, 2, 3, 4, 5}, isEven); count != 2 {
+		t.Fatalf("expected %d got %d", 2, count)
+	}
+}
+
+func TestFind(t *testing.T) {
+	slice := []int{1, 3, 4, 5, 6}
+	if value, ok := FindIf(slice, isEven); !ok || value != 4 {
+		t.Fatalf("FindIf: expected (%d, %v) got (%d, %v)", 4, true, value, ok)
+	}
+	if i := FindIndex(slice, isEven); i != 2 {
+		t.Fatalf("FindIndex: expected %d got %d", 2, i)
+	}
+	if value, ok := FindIf([]int{1, 3}, isEven); ok || value != 0 {
+		t.Fatalf("FindIf: expected (%d, %v) got (%d, %v)", 0, false, value, ok)
+	}
+	if i := FindIndex([]int{1, 3}, isEven); i != -1 {
+		t.Fatalf("FindIndex: expected %d got %d", -1, i)
+	}
+}
+
+func TestTakeDropWhile(t *testing.T) {
+	slice := []int{2, 4, 5, 6}
+	if result := TakeWhile(slice, isEven); !SliceEqual_(result, []int{2, 4}) {
+		t.Fatalf("TakeWhile: expected %v got %v", []int{2, 4}, result)
+	}
+	if result := DropWhile(slice, isEven); !SliceEqual_(result, []int{5, 6}) {
+		t.Fatalf("DropWhile: expected %v got %v", []int{5, 6}, result)
+	}
+	if result := TakeWhile(slice, func(int) bool { return true }); !SliceEqual_(result, slice) {
+		t.Fatalf("TakeWhile: expected %v got %v", slice, result)
+	}
+	if result := DropWhile(slice, func(int) bool { return true }); len(result) != 0 {
+		t.Fatalf("DropWhile: expected an empty slice got %v", result)
+	}
+
+	_ = append(TakeWhile(slice, isEven), 42)
+	if slice[2] != 5 {
+		t.Fatalf("TakeWhile: appending to the result overwrote the input %v", slice)
+	}
+}
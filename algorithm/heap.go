@@ -0,0 +1,68 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Same as C++ heaps: the slice is a binary max-heap according to comp
+// (with Less, slice[0] is the greatest element)
+
+// Heapify the slice in O(n)
+func MakeHeap[T any](slice []T, comp Ordf[T]) {
+	for i := len(slice)/2 - 1; i >= 0; i-- {
+		siftDown(slice, comp, i, len(slice))
+	}
+}
+
+// slice[:len(slice)-1] must be a heap, insert the last element in it
+//
+//	heap = append(heap, value)
+//	PushHeap(heap, comp)
+func PushHeap[T any](slice []T, comp Ordf[T]) {
+	siftUp(slice, comp, len(slice)-1)
+}
+
+// Move the front of the heap at the end of the slice, slice[:len(slice)-1] is left as a heap
+//
+//	PopHeap(heap, comp)
+//	front, heap := heap[len(heap)-1], heap[:len(heap)-1]
+func PopHeap[T any](slice []T, comp Ordf[T]) {
+	end := len(slice) - 1
+	if end <= 0 {
+		return
+	}
+	slice[0], slice[end] = slice[end], slice[0]
+	siftDown(slice, comp, 0, end)
+}
+
+// Turn a heap into a sorted slice
+func SortHeap[T any](slice []T, comp Ordf[T]) {
+	for end := len(slice); end > 1; end-- {
+		PopHeap(slice[:end], comp)
+	}
+}
+
+func IsHeap[T any](slice []T, comp Ordf[T]) bool {
+	return IsHeapUntil(slice, comp) == len(slice)
+}
+
+// Returns the index of the first element greater than its parent, len(slice) if the slice is a heap
+func IsHeapUntil[T any](slice []T, comp Ordf[T]) int {
+	for i := 1; i < len(slice); i++ {
+		if comp(slice[(i-1)/2], slice[i]) {
+			return i
+		}
+	}
+	return len(slice)
+}
+
+func siftUp[T any](heap []T, comp Ordf[T], child int) {
+	for child > 0 {
+		parent := (child - 1) / 2
+		if !comp(heap[parent], heap[child]) {
+			return
+		}
+		heap[parent], heap[child] = heap[child], heap[parent]
+		child = parent
+	}
+}
@@ -0,0 +1,69 @@
+package algorithm
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestMakeHeap(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 10, 1001} {
+		slice := randomInts(n, int64(n))
+		MakeHeap(slice, Less[int])
+		if !IsHeap(slice, Less[int]) {
+			t.Fatalf("n %d: MakeHeap did not build a heap", n)
+		}
+		if n > 0 && slice[0] != Max_(slice...) {
+			t.Fatalf("n %d: the front should be the greatest element", n)
+		}
+	}
+}
+
+func TestPushPopHeap(t *testing.T) {
+	input := randomInts(500, 42)
+	heap := make([]int, 0, len(input))
+	for _, v := range input {
+		heap = append(heap, v)
+		PushHeap(heap, Greater[int])
+		if !IsHeap(heap, Greater[int]) {
+			t.Fatalf("PushHeap broke the heap after %d elements", len(heap))
+		}
+	}
+
+	expected := append([]int{}, input...)
+	sort.Ints(expected)
+	for i := range expected {
+		PopHeap(heap, Greater[int])
+		var front int
+		front, heap = heap[len(heap)-1], heap[:len(heap)-1]
+		if front != expected[i] {
+			t.Fatalf("pop %d: expected %d got %d", i, expected[i], front)
+		}
+		if !IsHeap(heap, Greater[int]) {
+			t.Fatalf("PopHeap broke the heap at %d elements", len(heap))
+		}
+	}
+}
+
+func TestSortHeap(t *testing.T) {
+	slice := randomInts(1000, 7)
+	expected := append([]int{}, slice...)
+	sort.Ints(expected)
+
+	MakeHeap(slice, Less[int])
+	SortHeap(slice, Less[int])
+	if !SliceEqual_(slice, expected) {
+		t.Fatalf("SortHeap: the slice is not sorted")
+	}
+}
+
+func TestIsHeapUntil(t *testing.T) {
+	slice := []int{9, 5, 8, 1, 6, 7}
+	if i := IsHeapUntil(slice, Less[int]); i != 4 {
+		t.Fatalf("IsHeapUntil: expected %d got %d", 4, i)
+	}
+	if !IsHeap([]int{}, Less[int]) || !IsHeap([]int{1}, Less[int]) {
+		t.Fatalf("IsHeap: empty and single element slices are heaps")
+	}
+}
@@ -0,0 +1,111 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Returns a new sorted slice, on equality the elements of a come first
+func Merge[T any](a, b []T, comp Ordf[T]) []T {
+	result := make([]T, len(a)+len(b))
+	mergeInto(result, a, b, comp)
+	return result
+}
+
+// Merge two sorted slices into dst, on equality the elements of a come first
+func mergeInto[T any](dst, a, b []T, comp Ordf[T]) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
+
+// Merge the sorted ranges slice[:mid] and slice[mid:] without allocation, stable
+func InplaceMerge[T any](slice []T, mid int, comp Ordf[T]) {
+	if mid <= 0 || mid >= len(slice) {
+		return
+	}
+	symMerge(slice, comp, 0, mid, len(slice))
+}
+
+// SymMerge (Kim & Kutzner) of slice[a:m] and slice[m:b], O(n log n) comparisons and swaps
+func symMerge[T any](slice []T, comp Ordf[T], a, m, b int) {
+	if m-a == 1 {
+		// insert slice[a] in slice[m:b], after its equal elements
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if comp(slice[h], slice[a]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			slice[k], slice[k+1] = slice[k+1], slice[k]
+		}
+		return
+	}
+	if b-m == 1 {
+		// insert slice[m] in slice[a:m], after its equal elements
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if !comp(slice[m], slice[h]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			slice[k], slice[k-1] = slice[k-1], slice[k]
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if !comp(slice[p-c], slice[c]) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotateRange(slice, start, m, end)
+	}
+	if a < start && start < mid {
+		symMerge(slice, comp, a, start, mid)
+	}
+	if mid < end && end < b {
+		symMerge(slice, comp, mid, end, b)
+	}
+}
+
+// slice[m] becomes the first element of slice[a:b]
+func rotateRange[T any](slice []T, a, m, b int) {
+	reverseRange(slice, a, m)
+	reverseRange(slice, m, b)
+	reverseRange(slice, a, b)
+}
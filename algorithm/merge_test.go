@@ -0,0 +1,118 @@
+package algorithm
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+type keyIndex struct {
+	key   int
+	index int // position before sorting
+}
+
+func lessKey(a, b keyIndex) bool { return a.key < b.key }
+
+func randomKeyIndexes(n, keys int, seed int64) []keyIndex {
+	r := rand.New(rand.NewSource(seed))
+	slice := make([]keyIndex, n)
+	for i := range slice {
+		slice[i] = keyIndex{key: r.Intn(keys), index: i}
+	}
+	return slice
+}
+
+func checkStable(t *testing.T, name string, slice []keyIndex) {
+	for i := 1; i < len(slice); i++ {
+		a, b := slice[i-1], slice[i]
+		if a.key > b.key {
+			t.Fatalf("%s: %d: %v should be before %v", name, i, b, a)
+		}
+		if a.key == b.key && a.index > b.index {
+			t.Fatalf("%s: %d: the order of equal elements %v and %v is not preserved", name, i, a, b)
+		}
+	}
+}
+
+func TestStableSort(t *testing.T) {
+	for _, n := range []int{0, 1, 19, 20, 21, 100, 1000, 12345} {
+		for _, keys := range []int{1, 3, 100, 1 << 30} {
+			slice := randomKeyIndexes(n, keys, int64(n*keys))
+			StableSort(slice, lessKey)
+			checkStable(t, "StableSort", slice)
+		}
+	}
+}
+
+func TestStableSortMultiKey(t *testing.T) {
+	type record struct {
+		name string
+		age  int
+	}
+	slice := []record{{"b", 2}, {"a", 1}, {"c", 2}, {"a", 2}, {"b", 1}}
+
+	// sort by the secondary key first then by the primary one
+	StableSort(slice, func(a, b record) bool { return a.name < b.name })
+	StableSort(slice, func(a, b record) bool { return a.age < b.age })
+
+	expected := []record{{"a", 1}, {"b", 1}, {"a", 2}, {"b", 2}, {"c", 2}}
+	if !SliceEqual_(slice, expected) {
+		t.Fatalf("expected %v got %v", expected, slice)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := []int{1, 3, 3, 5, 9}
+	b := []int{0, 3, 4, 10, 11, 12}
+	expected := []int{0, 1, 3, 3, 3, 4, 5, 9, 10, 11, 12}
+	if result := Merge(a, b, Less[int]); !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := Merge(nil, b, Less[int]); !SliceEqual_(result, b) {
+		t.Fatalf("expected %v got %v", b, result)
+	}
+
+	// the elements of the first slice come first on equality
+	left := []keyIndex{{1, 0}, {2, 1}}
+	right := []keyIndex{{1, 2}, {2, 3}}
+	checkStable(t, "Merge", Merge(left, right, lessKey))
+}
+
+func TestInplaceMerge(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 100, 1001} {
+		for _, mid := range []int{0, 1, n / 3, n / 2, n - 1, n} {
+			if mid < 0 || mid > n {
+				continue
+			}
+			slice := randomKeyIndexes(n, 10, int64(n+mid))
+			sort.SliceStable(slice[:mid], func(i, j int) bool { return slice[i].key < slice[j].key })
+			sort.SliceStable(slice[mid:], func(i, j int) bool { return slice[mid+i].key < slice[mid+j].key })
+
+			InplaceMerge(slice, mid, lessKey)
+			checkStable(t, "InplaceMerge", slice)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		slice []int
+		until int
+	}{
+		{[]int{}, 0},
+		{[]int{1}, 1},
+		{[]int{1, 2, 2, 3}, 4},
+		{[]int{1, 2, 1, 3}, 2},
+		{[]int{3, 2, 1}, 1},
+	}
+	for _, test := range tests {
+		if until := IsSortedUntil(test.slice, Less[int]); until != test.until {
+			t.Fatalf("IsSortedUntil(%v): expected %d got %d", test.slice, test.until, until)
+		}
+		if sorted := IsSorted(test.slice, Less[int]); sorted != (test.until == len(test.slice)) {
+			t.Fatalf("IsSorted(%v): expected %v got %v", test.slice, !sorted, sorted)
+		}
+	}
+}
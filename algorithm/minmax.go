@@ -0,0 +1,124 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// On ties, the functions below return the first minimum and the first maximum
+// ok is false if the slice is empty
+
+// Index of the minimum, -1 if the slice is empty
+func ArgMin[T any](slice []T, comp Ordf[T]) int {
+	if len(slice) == 0 {
+		return -1
+	}
+	min := 0
+	for i := 1; i < len(slice); i++ {
+		if comp(slice[i], slice[min]) {
+			min = i
+		}
+	}
+	return min
+}
+
+// Index of the maximum, -1 if the slice is empty
+func ArgMax[T any](slice []T, comp Ordf[T]) int {
+	if len(slice) == 0 {
+		return -1
+	}
+	max := 0
+	for i := 1; i < len(slice); i++ {
+		if comp(slice[max], slice[i]) {
+			max = i
+		}
+	}
+	return max
+}
+
+func MinBy[T any](slice []T, comp Ordf[T]) (T, bool) {
+	return elementAt(slice, ArgMin(slice, comp))
+}
+
+func MaxBy[T any](slice []T, comp Ordf[T]) (T, bool) {
+	return elementAt(slice, ArgMax(slice, comp))
+}
+
+func MinMax[T any](slice []T, comp Ordf[T]) (min, max T, ok bool) {
+	if len(slice) == 0 {
+		return min, max, false
+	}
+	min, max = slice[0], slice[0]
+	for _, a := range slice[1:] {
+		if comp(a, min) {
+			min = a
+		} else if comp(max, a) {
+			max = a
+		}
+	}
+	return min, max, true
+}
+
+func MinMax_[T Ord](slice []T) (min, max T, ok bool) {
+	return MinMax(slice, Less[T])
+}
+
+// keyf is called once per element
+func ArgMinByKey[T any, K Ord](slice []T, keyf func(T) K) int {
+	if len(slice) == 0 {
+		return -1
+	}
+	min, minKey := 0, keyf(slice[0])
+	for i := 1; i < len(slice); i++ {
+		if key := keyf(slice[i]); key < minKey {
+			min, minKey = i, key
+		}
+	}
+	return min
+}
+
+// keyf is called once per element
+func ArgMaxByKey[T any, K Ord](slice []T, keyf func(T) K) int {
+	if len(slice) == 0 {
+		return -1
+	}
+	max, maxKey := 0, keyf(slice[0])
+	for i := 1; i < len(slice); i++ {
+		if key := keyf(slice[i]); key > maxKey {
+			max, maxKey = i, key
+		}
+	}
+	return max
+}
+
+func MinByKey[T any, K Ord](slice []T, keyf func(T) K) (T, bool) {
+	return elementAt(slice, ArgMinByKey(slice, keyf))
+}
+
+func MaxByKey[T any, K Ord](slice []T, keyf func(T) K) (T, bool) {
+	return elementAt(slice, ArgMaxByKey(slice, keyf))
+}
+
+func MinMaxByKey[T any, K Ord](slice []T, keyf func(T) K) (min, max T, ok bool) {
+	if len(slice) == 0 {
+		return min, max, false
+	}
+	min, max = slice[0], slice[0]
+	minKey := keyf(slice[0])
+	maxKey := minKey
+	for _, a := range slice[1:] {
+		if key := keyf(a); key < minKey {
+			min, minKey = a, key
+		} else if key > maxKey {
+			max, maxKey = a, key
+		}
+	}
+	return min, max, true
+}
+
+func elementAt[T any](slice []T, i int) (T, bool) {
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+	return slice[i], true
+}
@@ -0,0 +1,75 @@
+package algorithm
+
+import (
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		slice          []int
+		argMin, argMax int
+		min, max       int
+		ok             bool
+	}{
+		{[]int{}, -1, -1, 0, 0, false},
+		{[]int{4}, 0, 0, 4, 4, true},
+		{[]int{3, 1, 4, 1, 5, 9, 2, 9}, 1, 5, 1, 9, true},
+		{[]int{2, 2, 2}, 0, 0, 2, 2, true},
+	}
+
+	for _, test := range tests {
+		if i := ArgMin(test.slice, Less[int]); i != test.argMin {
+			t.Fatalf("ArgMin(%v): expected %d got %d", test.slice, test.argMin, i)
+		}
+		if i := ArgMax(test.slice, Less[int]); i != test.argMax {
+			t.Fatalf("ArgMax(%v): expected %d got %d", test.slice, test.argMax, i)
+		}
+		if min, ok := MinBy(test.slice, Less[int]); min != test.min || ok != test.ok {
+			t.Fatalf("MinBy(%v): expected (%d, %v) got (%d, %v)", test.slice, test.min, test.ok, min, ok)
+		}
+		if max, ok := MaxBy(test.slice, Less[int]); max != test.max || ok != test.ok {
+			t.Fatalf("MaxBy(%v): expected (%d, %v) got (%d, %v)", test.slice, test.max, test.ok, max, ok)
+		}
+		if min, max, ok := MinMax_(test.slice); min != test.min || max != test.max || ok != test.ok {
+			t.Fatalf("MinMax_(%v): expected (%d, %d, %v) got (%d, %d, %v)", test.slice, test.min, test.max, test.ok, min, max, ok)
+		}
+	}
+}
+
+func TestMinMaxByKey(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"a", 30}, {"b", 18}, {"c", 42}, {"d", 18}, {"e", 42}}
+	age := func(u user) int { return u.age }
+
+	if u, ok := MinByKey(users, age); !ok || u.name != "b" {
+		t.Fatalf("MinByKey: expected %v got %v", users[1], u)
+	}
+	if u, ok := MaxByKey(users, age); !ok || u.name != "c" {
+		t.Fatalf("MaxByKey: expected %v got %v", users[2], u)
+	}
+	if min, max, ok := MinMaxByKey(users, age); !ok || min.name != "b" || max.name != "c" {
+		t.Fatalf("MinMaxByKey: expected (%v, %v) got (%v, %v)", users[1], users[2], min, max)
+	}
+	if i := ArgMinByKey(users, age); i != 1 {
+		t.Fatalf("ArgMinByKey: expected %d got %d", 1, i)
+	}
+	if i := ArgMaxByKey(users, age); i != 2 {
+		t.Fatalf("ArgMaxByKey: expected %d got %d", 2, i)
+	}
+	if _, ok := MinByKey([]user{}, age); ok {
+		t.Fatalf("MinByKey on an empty slice should not be ok")
+	}
+	if _, _, ok := MinMaxByKey([]user{}, age); ok {
+		t.Fatalf("MinMaxByKey on an empty slice should not be ok")
+	}
+
+	older := func(a, b user) bool { return a.age < b.age }
+	if u, ok := MaxBy(users, older); !ok || u.name != "c" {
+		t.Fatalf("MaxBy: expected %v got %v", users[2], u)
+	}
+}
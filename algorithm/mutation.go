@@ -0,0 +1,101 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// In place manipulations, with the semantics of their C++ STL counterparts
+
+func Reverse[T any](slice []T) {
+	reverseRange(slice, 0, len(slice))
+}
+
+// slice[k] becomes the first element, k is taken modulo len(slice)
+func RotateLeft[T any](slice []T, k int) {
+	if len(slice) == 0 {
+		return
+	}
+	k %= len(slice)
+	if k < 0 {
+		k += len(slice)
+	}
+	rotateRange(slice, 0, k, len(slice))
+}
+
+// slice[len(slice)-k] becomes the first element, k is taken modulo len(slice)
+func RotateRight[T any](slice []T, k int) {
+	RotateLeft(slice, -k)
+}
+
+// Move the elements satisfying pred before the others, keeping their relative order in both groups
+// Returns the index of the first element not satisfying pred
+func StablePartition[T any](slice []T, pred Eqf[T]) int {
+	var rejected []T
+	n := 0
+	for _, a := range slice {
+		if pred(a) {
+			slice[n] = a
+			n++
+		} else {
+			rejected = append(rejected, a)
+		}
+	}
+	copy(slice[n:], rejected)
+	return n
+}
+
+// Remove the elements satisfying pred, keeping the order of the others
+// Returns the new length: slice[:n] holds the kept elements, the rest of the slice is zeroed
+func RemoveIf[T any](slice []T, pred Eqf[T]) int {
+	n := 0
+	for _, a := range slice {
+		if !pred(a) {
+			slice[n] = a
+			n++
+		}
+	}
+	clearTail(slice, n)
+	return n
+}
+
+func Fill[T any](slice []T, value T) {
+	for i := range slice {
+		slice[i] = value
+	}
+}
+
+// Assign the successive results of f to the elements of the slice
+func Generate[T any](slice []T, f func() T) {
+	for i := range slice {
+		slice[i] = f()
+	}
+}
+
+// Fill the slice with start, start+1, start+2...
+func Iota[T IntegerType](slice []T, start T) {
+	for i := range slice {
+		slice[i] = start
+		start++
+	}
+}
+
+func Replace[T comparable](slice []T, old, new T) {
+	ReplaceIf(slice, func(a T) bool { return a == old }, new)
+}
+
+func ReplaceIf[T any](slice []T, pred Eqf[T], new T) {
+	for i := range slice {
+		if pred(slice[i]) {
+			slice[i] = new
+		}
+	}
+}
+
+// Swap the elements of a and b pairwise, returns the number of swapped elements
+func SwapRanges[T any](a, b []T) int {
+	n := Min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		a[i], b[i] = b[i], a[i]
+	}
+	return n
+}
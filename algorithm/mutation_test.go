@@ -0,0 +1,113 @@
+package algorithm
+
+import (
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		slice, expected []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2}, []int{2, 1}},
+		{[]int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+	for _, test := range tests {
+		slice := append([]int{}, test.slice...)
+		Reverse(slice)
+		if !SliceEqual_(slice, test.expected) {
+			t.Fatalf("Reverse(%v): expected %v got %v", test.slice, test.expected, slice)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		k           int
+		left, right []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}, []int{4, 5, 1, 2, 3}},
+		{5, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{7, []int{3, 4, 5, 1, 2}, []int{4, 5, 1, 2, 3}},
+		{-1, []int{5, 1, 2, 3, 4}, []int{2, 3, 4, 5, 1}},
+	}
+	for _, test := range tests {
+		left := []int{1, 2, 3, 4, 5}
+		RotateLeft(left, test.k)
+		if !SliceEqual_(left, test.left) {
+			t.Fatalf("RotateLeft(%d): expected %v got %v", test.k, test.left, left)
+		}
+		right := []int{1, 2, 3, 4, 5}
+		RotateRight(right, test.k)
+		if !SliceEqual_(right, test.right) {
+			t.Fatalf("RotateRight(%d): expected %v got %v", test.k, test.right, right)
+		}
+	}
+	RotateLeft([]int{}, 3)
+}
+
+func TestStablePartition(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6, 7}
+	n := StablePartition(slice, isEven)
+	if n != 3 || !SliceEqual_(slice, []int{2, 4, 6, 1, 3, 5, 7}) {
+		t.Fatalf("expected %d %v got %d %v", 3, []int{2, 4, 6, 1, 3, 5, 7}, n, slice)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6, 7}
+	n := RemoveIf(slice, isEven)
+	if n != 4 || !SliceEqual_(slice, []int{1, 3, 5, 7, 0, 0, 0}) {
+		t.Fatalf("expected %d %v got %d %v", 4, []int{1, 3, 5, 7, 0, 0, 0}, n, slice)
+	}
+	if n := RemoveIf([]int{}, isEven); n != 0 {
+		t.Fatalf("expected %d got %d", 0, n)
+	}
+}
+
+func TestFillGenerateIota(t *testing.T) {
+	slice := make([]int, 4)
+	Fill(slice, 7)
+	if !SliceEqual_(slice, []int{7, 7, 7, 7}) {
+		t.Fatalf("Fill: expected %v got %v", []int{7, 7, 7, 7}, slice)
+	}
+
+	i := 0
+	Generate(slice, func() int { i++; return i * i })
+	if !SliceEqual_(slice, []int{1, 4, 9, 16}) {
+		t.Fatalf("Generate: expected %v got %v", []int{1, 4, 9, 16}, slice)
+	}
+
+	bytes := make([]uint8, 3)
+	Iota(bytes, 254)
+	if !SliceEqual_(bytes, []uint8{254, 255, 0}) {
+		t.Fatalf("Iota: expected %v got %v", []uint8{254, 255, 0}, bytes)
+	}
+	negatives := make([]int64, 3)
+	Iota(negatives, -1)
+	if !SliceEqual_(negatives, []int64{-1, 0, 1}) {
+		t.Fatalf("Iota: expected %v got %v", []int64{-1, 0, 1}, negatives)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	slice := []int{1, 2, 1, 3}
+	Replace(slice, 1, 9)
+	if !SliceEqual_(slice, []int{9, 2, 9, 3}) {
+		t.Fatalf("Replace: expected %v got %v", []int{9, 2, 9, 3}, slice)
+	}
+	ReplaceIf(slice, isEven, 0)
+	if !SliceEqual_(slice, []int{9, 0, 9, 3}) {
+		t.Fatalf("ReplaceIf: expected %v got %v", []int{9, 0, 9, 3}, slice)
+	}
+}
+
+func TestSwapRanges(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := []int{4, 5}
+	if n := SwapRanges(a, b); n != 2 || !SliceEqual_(a, []int{4, 5, 3}) || !SliceEqual_(b, []int{1, 2}) {
+		t.Fatalf("expected %d %v %v got %d %v %v", 2, []int{4, 5, 3}, []int{1, 2}, n, a, b)
+	}
+}
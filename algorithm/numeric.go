@@ -0,0 +1,102 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Same as C++ <numeric>: the functions take a custom operation,
+// their _ variant uses the arithmetic operators on NumberType
+
+// Left fold of the slice: op(...op(op(init, slice[0]), slice[1])..., slice[n-1])
+func Accumulate[T, U any](slice []T, init U, op func(U, T) U) U {
+	return Reduce(slice, init, op)
+}
+
+// init + the sum of the elements
+func Accumulate_[T NumberType](slice []T, init T) T {
+	for _, a := range slice {
+		init += a
+	}
+	return init
+}
+
+// result[i] = op(...op(slice[0], slice[1])..., slice[i]), returns a new slice
+func InclusiveScan[T any](slice []T, op func(T, T) T) []T {
+	result := append([]T{}, slice...)
+	PartialSum(result, op)
+	return result
+}
+
+func InclusiveScan_[T NumberType](slice []T) []T {
+	return InclusiveScan(slice, add[T])
+}
+
+// result[0] = init, result[i] = op(result[i-1], slice[i-1]): slice[i] is excluded from result[i]
+func ExclusiveScan[T any](slice []T, init T, op func(T, T) T) []T {
+	result := make([]T, len(slice))
+	for i, a := range slice {
+		result[i] = init
+		init = op(init, a)
+	}
+	return result
+}
+
+func ExclusiveScan_[T NumberType](slice []T, init T) []T {
+	return ExclusiveScan(slice, init, add[T])
+}
+
+// In place inclusive scan
+func PartialSum[T any](slice []T, op func(T, T) T) {
+	for i := 1; i < len(slice); i++ {
+		slice[i] = op(slice[i-1], slice[i])
+	}
+}
+
+func PartialSum_[T NumberType](slice []T) {
+	PartialSum(slice, add[T])
+}
+
+// result[0] = slice[0], result[i] = op(slice[i], slice[i-1]), returns a new slice
+func AdjacentDifference[T any](slice []T, op func(T, T) T) []T {
+	result := make([]T, len(slice))
+	for i := range slice {
+		if i == 0 {
+			result[i] = slice[i]
+		} else {
+			result[i] = op(slice[i], slice[i-1])
+		}
+	}
+	return result
+}
+
+func AdjacentDifference_[T NumberType](slice []T) []T {
+	return AdjacentDifference(slice, func(a, b T) T { return a - b })
+}
+
+// sum(...sum(sum(init, product(a[0], b[0])), product(a[1], b[1]))...), stops at the end of the shortest slice
+func InnerProduct[A, B, U any](a []A, b []B, init U, sum func(U, U) U, product func(A, B) U) U {
+	for i, n := 0, Min(len(a), len(b)); i < n; i++ {
+		init = sum(init, product(a[i], b[i]))
+	}
+	return init
+}
+
+// init + a[0]*b[0] + a[1]*b[1]...
+func InnerProduct_[T NumberType](a, b []T, init T) T {
+	return InnerProduct(a, b, init, add[T], func(x, y T) T { return x * y })
+}
+
+// reduce(...reduce(reduce(init, transform(slice[0])), transform(slice[1]))...)
+func TransformReduce[T, U any](slice []T, init U, reduce func(U, U) U, transform func(T) U) U {
+	for _, a := range slice {
+		init = reduce(init, transform(a))
+	}
+	return init
+}
+
+// init + the sum of the transformed elements
+func TransformReduce_[T any, U NumberType](slice []T, init U, transform func(T) U) U {
+	return TransformReduce(slice, init, add[U], transform)
+}
+
+func add[T NumberType](a, b T) T { return a + b }
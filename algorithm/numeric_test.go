@@ -0,0 +1,97 @@
+package algorithm
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestAccumulate(t *testing.T) {
+	if sum := Accumulate_([]int{1, 2, 3, 4}, 10); sum != 20 {
+		t.Fatalf("Accumulate_: expected %d got %d", 20, sum)
+	}
+	if sum := Accumulate_([]float64{}, 1.5); sum != 1.5 {
+		t.Fatalf("Accumulate_: expected %v got %v", 1.5, sum)
+	}
+	concat := Accumulate([]int{1, 2, 3}, "", func(s string, i int) string { return s + strconv.Itoa(i) })
+	if concat != "123" {
+		t.Fatalf("Accumulate: expected %s got %s", "123", concat)
+	}
+}
+
+func TestScans(t *testing.T) {
+	slice := []int{1, 2, 3, 4}
+
+	if result := InclusiveScan_(slice); !SliceEqual_(result, []int{1, 3, 6, 10}) {
+		t.Fatalf("InclusiveScan_: expected %v got %v", []int{1, 3, 6, 10}, result)
+	}
+	if result := ExclusiveScan_(slice, 0); !SliceEqual_(result, []int{0, 1, 3, 6}) {
+		t.Fatalf("ExclusiveScan_: expected %v got %v", []int{0, 1, 3, 6}, result)
+	}
+	mul := func(a, b int) int { return a * b }
+	if result := InclusiveScan(slice, mul); !SliceEqual_(result, []int{1, 2, 6, 24}) {
+		t.Fatalf("InclusiveScan: expected %v got %v", []int{1, 2, 6, 24}, result)
+	}
+	if result := ExclusiveScan(slice, 1, mul); !SliceEqual_(result, []int{1, 1, 2, 6}) {
+		t.Fatalf("ExclusiveScan: expected %v got %v", []int{1, 1, 2, 6}, result)
+	}
+	if !SliceEqual_(slice, []int{1, 2, 3, 4}) {
+		t.Fatalf("the scans should not modify their input")
+	}
+
+	PartialSum_(slice)
+	if !SliceEqual_(slice, []int{1, 3, 6, 10}) {
+		t.Fatalf("PartialSum_: expected %v got %v", []int{1, 3, 6, 10}, slice)
+	}
+	if result := InclusiveScan_([]int{}); len(result) != 0 {
+		t.Fatalf("InclusiveScan_: expected an empty slice got %v", result)
+	}
+}
+
+func TestAdjacentDifference(t *testing.T) {
+	slice := []int{1, 3, 6, 10}
+	if result := AdjacentDifference_(slice); !SliceEqual_(result, []int{1, 2, 3, 4}) {
+		t.Fatalf("AdjacentDifference_: expected %v got %v", []int{1, 2, 3, 4}, result)
+	}
+
+	// inverse of the inclusive scan
+	if result := InclusiveScan_(AdjacentDifference_(slice)); !SliceEqual_(result, slice) {
+		t.Fatalf("expected %v got %v", slice, result)
+	}
+
+	ratio := AdjacentDifference([]float64{1, 2, 8}, func(a, b float64) float64 { return a / b })
+	if !SliceEqual_(ratio, []float64{1, 2, 4}) {
+		t.Fatalf("AdjacentDifference: expected %v got %v", []float64{1, 2, 4}, ratio)
+	}
+}
+
+func TestInnerProduct(t *testing.T) {
+	if result := InnerProduct_([]int{1, 2, 3}, []int{4, 5, 6}, 0); result != 32 {
+		t.Fatalf("InnerProduct_: expected %d got %d", 32, result)
+	}
+	if result := InnerProduct_([]int{1, 2, 3}, []int{4}, 1); result != 5 {
+		t.Fatalf("InnerProduct_: expected %d got %d", 5, result)
+	}
+
+	matches := InnerProduct([]string{"a", "b", "c"}, []string{"a", "x", "c"}, 0,
+		func(a, b int) int { return a + b },
+		func(a, b string) int {
+			if a == b {
+				return 1
+			}
+			return 0
+		})
+	if matches != 2 {
+		t.Fatalf("InnerProduct: expected %d got %d", 2, matches)
+	}
+}
+
+func TestTransformReduce(t *testing.T) {
+	words := []string{"a", "bb", "ccc"}
+	if length := TransformReduce_(words, 0, func(s string) int { return len(s) }); length != 6 {
+		t.Fatalf("TransformReduce_: expected %d got %d", 6, length)
+	}
+	longest := TransformReduce(words, 0, Max[int], func(s string) int { return len(s) })
+	if longest != 3 {
+		t.Fatalf("TransformReduce: expected %d got %d", 3, longest)
+	}
+}
@@ -0,0 +1,154 @@
+package algorithm
+
+import (
+	"runtime"
+	"sync"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+	threadpool "github.com/AlexandreChamard/go-generic/threadPool"
+)
+
+type ParallelConfig struct {
+	// Must be running during the whole call. On nil, a pool of runtime.NumCPU() workers is created for the call
+	// The Parallel functions must not be called from a task of this pool: they block a worker
+	// while waiting for tasks queued on the same pool, which deadlocks once every worker waits
+	Pool threadpool.ThreadPool
+	// Number of elements handled by a single task. On <=0, the slice is split in 4 chunks per CPU
+	GrainSize int
+}
+
+func (this ParallelConfig) grainSize(n int) int {
+	if this.GrainSize > 0 {
+		return this.GrainSize
+	}
+	chunks := 4 * runtime.NumCPU()
+	return Max((n+chunks-1)/chunks, 1)
+}
+
+// Call f on every [low, high) chunk of a slice of size n and wait for all of them
+// Panics if the pool is not running: its Submit would drop the tasks and the wait would never end
+func parallelChunks(n int, config ParallelConfig, f func(chunk, low, high int)) {
+	if n == 0 {
+		return
+	}
+	pool := config.Pool
+	if pool == nil {
+		pool = threadpool.NewThreadPool(threadpool.ThreadPoolConfig{PoolSize: runtime.NumCPU()})
+		defer func() {
+			pool.Stop()
+			pool.Wait()
+		}()
+	}
+
+	grain := config.grainSize(n)
+	wg := sync.WaitGroup{}
+	for chunk, low := 0, 0; low < n; chunk, low = chunk+1, low+grain {
+		chunk, low, high := chunk, low, Min(low+grain, n)
+		if !pool.Running() {
+			panic("algorithm: ParallelConfig.Pool is not running")
+		}
+		wg.Add(1)
+		pool.Submit(func() {
+			defer wg.Done()
+			f(chunk, low, high)
+		})
+	}
+	wg.Wait()
+}
+
+func nbChunks(n, grain int) int {
+	return (n + grain - 1) / grain
+}
+
+func ParallelForEach[T any](slice []T, f func(int, T), config ParallelConfig) {
+	parallelChunks(len(slice), config, func(_, low, high int) {
+		for i := low; i < high; i++ {
+			f(i, slice[i])
+		}
+	})
+}
+
+func ParallelMap[T, U any](slice []T, f func(T) U, config ParallelConfig) []U {
+	result := make([]U, len(slice))
+	parallelChunks(len(slice), config, func(_, low, high int) {
+		for i := low; i < high; i++ {
+			result[i] = f(slice[i])
+		}
+	})
+	return result
+}
+
+// The relative order of the kept elements is preserved
+func ParallelFilter[T any](slice []T, pred Eqf[T], config ParallelConfig) []T {
+	chunks := make([][]T, nbChunks(len(slice), config.grainSize(len(slice))))
+	parallelChunks(len(slice), config, func(chunk, low, high int) {
+		kept := []T{}
+		for i := low; i < high; i++ {
+			if pred(slice[i]) {
+				kept = append(kept, slice[i])
+			}
+		}
+		chunks[chunk] = kept
+	})
+
+	size := 0
+	for _, chunk := range chunks {
+		size += len(chunk)
+	}
+	result := make([]T, 0, size)
+	for _, chunk := range chunks {
+		result = append(result, chunk...)
+	}
+	return result
+}
+
+// op must be associative, the partial results are combined in the slice order
+func ParallelReduce[T any](slice []T, init T, op func(T, T) T, config ParallelConfig) T {
+	partials := make([]T, nbChunks(len(slice), config.grainSize(len(slice))))
+	parallelChunks(len(slice), config, func(chunk, low, high int) {
+		acc := slice[low]
+		for i := low + 1; i < high; i++ {
+			acc = op(acc, slice[i])
+		}
+		partials[chunk] = acc
+	})
+
+	result := init
+	for _, partial := range partials {
+		result = op(result, partial)
+	}
+	return result
+}
+
+// Each chunk is sorted with Sort, then the sorted runs are merged two by two
+func ParallelSort[T any](slice []T, comp Ordf[T], config ParallelConfig) {
+	n := len(slice)
+	if n <= 1 {
+		return
+	}
+	if config.Pool == nil {
+		// share the same pool between the sorting and all the merging passes
+		config.Pool = threadpool.NewThreadPool(threadpool.ThreadPoolConfig{PoolSize: runtime.NumCPU()})
+		defer func() {
+			config.Pool.Stop()
+			config.Pool.Wait()
+		}()
+	}
+	grain := config.grainSize(n)
+
+	parallelChunks(n, config, func(_, low, high int) {
+		Sort(slice[low:high], comp)
+	})
+
+	src, dst := slice, make([]T, n)
+	for width := grain; width < n; width *= 2 {
+		parallelChunks(n, ParallelConfig{Pool: config.Pool, GrainSize: 2 * width}, func(_, low, high int) {
+			mid := Min(low+width, high)
+			mergeInto(dst[low:high], src[low:mid], src[mid:high], comp)
+		})
+		src, dst = dst, src
+	}
+	if &src[0] != &slice[0] {
+		copy(slice, src)
+	}
+}
@@ -0,0 +1,120 @@
+package algorithm
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+	threadpool "github.com/AlexandreChamard/go-generic/threadPool"
+)
+
+func randomInts(n int, seed int64) []int {
+	r := rand.New(rand.NewSource(seed))
+	slice := make([]int, n)
+	for i := range slice {
+		slice[i] = r.Intn(1000) - 500
+	}
+	return slice
+}
+
+func TestParallelMap(t *testing.T) {
+	slice := randomInts(10000, 1)
+	for _, grain := range []int{0, 1, 7, 10000, 20000} {
+		result := ParallelMap(slice, func(i int) int { return i * 2 }, ParallelConfig{GrainSize: grain})
+		for i := range slice {
+			if result[i] != slice[i]*2 {
+				t.Fatalf("grain %d: result[%d]: expected %d got %d", grain, i, slice[i]*2, result[i])
+			}
+		}
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	slice := randomInts(10000, 2)
+	pred := func(i int) bool { return i%3 == 0 }
+
+	expected := []int{}
+	for _, i := range slice {
+		if pred(i) {
+			expected = append(expected, i)
+		}
+	}
+
+	for _, grain := range []int{0, 1, 13, 20000} {
+		result := ParallelFilter(slice, pred, ParallelConfig{GrainSize: grain})
+		if !SliceEqual_(result, expected) {
+			t.Fatalf("grain %d: ParallelFilter does not keep the order", grain)
+		}
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	slice := randomInts(10000, 3)
+	expected := 0
+	for _, i := range slice {
+		expected += i
+	}
+
+	for _, grain := range []int{0, 1, 99, 20000} {
+		result := ParallelReduce(slice, 0, func(a, b int) int { return a + b }, ParallelConfig{GrainSize: grain})
+		if result != expected {
+			t.Fatalf("grain %d: expected %d got %d", grain, expected, result)
+		}
+	}
+	if result := ParallelReduce([]int{}, 42, func(a, b int) int { return a + b }, ParallelConfig{}); result != 42 {
+		t.Fatalf("empty slice: expected %d got %d", 42, result)
+	}
+
+	// non commutative operation: the order of the partial results matters
+	strs := []string{"a", "b", "c", "d", "e", "f", "g"}
+	concat := ParallelReduce(strs, ">", func(a, b string) string { return a + b }, ParallelConfig{GrainSize: 2})
+	if concat != ">abcdefg" {
+		t.Fatalf("expected %s got %s", ">abcdefg", concat)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	slice := randomInts(1000, 4)
+	seen := make([]bool, len(slice))
+	ParallelForEach(slice, func(i int, _ int) { seen[i] = true }, ParallelConfig{GrainSize: 10})
+	for i := range seen {
+		if !seen[i] {
+			t.Fatalf("index %d has not been visited", i)
+		}
+	}
+}
+
+func TestParallelStoppedPool(t *testing.T) {
+	pool := threadpool.NewThreadPool(threadpool.ThreadPoolConfig{PoolSize: 2})
+	pool.Stop()
+	pool.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("ParallelForEach on a stopped pool should panic")
+		}
+	}()
+	ParallelForEach([]int{1, 2, 3}, func(int, int) {}, ParallelConfig{Pool: pool})
+}
+
+func TestParallelSort(t *testing.T) {
+	pool := threadpool.NewThreadPool(threadpool.ThreadPoolConfig{PoolSize: 4})
+	defer func() {
+		pool.Stop()
+		pool.Wait()
+	}()
+
+	for _, n := range []int{0, 1, 2, 100, 10007} {
+		for _, grain := range []int{0, 1, 3, 64, 20000} {
+			slice := randomInts(n, int64(n+grain))
+			expected := append([]int{}, slice...)
+			sort.Ints(expected)
+
+			ParallelSort(slice, Less[int], ParallelConfig{Pool: pool, GrainSize: grain})
+			if !SliceEqual_(slice, expected) {
+				t.Fatalf("n %d grain %d: the slice is not sorted", n, grain)
+			}
+		}
+	}
+}
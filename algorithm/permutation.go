@@ -0,0 +1,183 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Transform the slice into the next permutation in the lexicographical order defined by comp
+// Returns false if it was the last one, the slice is then sorted (first permutation)
+func NextPermutation[T any](slice []T, comp Ordf[T]) bool {
+	i := len(slice) - 2
+	for i >= 0 && !comp(slice[i], slice[i+1]) {
+		i--
+	}
+	if i < 0 {
+		reverseRange(slice, 0, len(slice))
+		return false
+	}
+	j := len(slice) - 1
+	for !comp(slice[i], slice[j]) {
+		j--
+	}
+	slice[i], slice[j] = slice[j], slice[i]
+	reverseRange(slice, i+1, len(slice))
+	return true
+}
+
+// Transform the slice into the previous permutation in the lexicographical order defined by comp
+// Returns false if it was the first one, the slice is then sorted in reverse order (last permutation)
+func PrevPermutation[T any](slice []T, comp Ordf[T]) bool {
+	return NextPermutation(slice, func(a, b T) bool { return comp(b, a) })
+}
+
+// The iterators below call yield on each generated value until it returns false
+// The slice given to yield is reused between calls: copy it to keep it
+
+// All the len(slice)! orderings of the slice, the elements are considered distinct by position
+func Permutations[T any](slice []T) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		indexes := firstIndexes(len(slice))
+		current := make([]T, len(slice))
+		for {
+			for i, index := range indexes {
+				current[i] = slice[index]
+			}
+			if !yield(current) || !NextPermutation(indexes, Less[int]) {
+				return
+			}
+		}
+	}
+}
+
+// All the subsets of k elements, in the order of the slice
+func Combinations[T any](slice []T, k int) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		n := len(slice)
+		if k < 0 || k > n {
+			return
+		}
+		indexes := firstIndexes(k)
+		current := make([]T, k)
+		for {
+			for i, index := range indexes {
+				current[i] = slice[index]
+			}
+			if !yield(current) {
+				return
+			}
+
+			// find the rightmost index which can still be moved forward
+			i := k - 1
+			for i >= 0 && indexes[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// All the multisets of k elements taken from the slice
+func CombinationsWithRepetition[T any](slice []T, k int) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		n := len(slice)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+		indexes := make([]int, k)
+		current := make([]T, k)
+		for {
+			for i, index := range indexes {
+				current[i] = slice[index]
+			}
+			if !yield(current) {
+				return
+			}
+
+			i := k - 1
+			for i >= 0 && indexes[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[i]
+			}
+		}
+	}
+}
+
+// All the tuples made of one element of each slice, the last slice varies the fastest
+func CartesianProduct[T any](slices ...[]T) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		for _, slice := range slices {
+			if len(slice) == 0 {
+				return
+			}
+		}
+		indexes := make([]int, len(slices))
+		current := make([]T, len(slices))
+		for {
+			for i, index := range indexes {
+				current[i] = slices[i][index]
+			}
+			if !yield(current) {
+				return
+			}
+
+			i := len(slices) - 1
+			for i >= 0 && indexes[i] == len(slices[i])-1 {
+				indexes[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+		}
+	}
+}
+
+// All the 2^len(slice) subsets, starting with the empty one
+func PowerSet[T any](slice []T) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		selected := make([]bool, len(slice))
+		current := make([]T, 0, len(slice))
+		for {
+			current = current[:0]
+			for i, ok := range selected {
+				if ok {
+					current = append(current, slice[i])
+				}
+			}
+			if !yield(current) {
+				return
+			}
+
+			// binary increment of the selection
+			i := 0
+			for i < len(selected) && selected[i] {
+				selected[i] = false
+				i++
+			}
+			if i == len(selected) {
+				return
+			}
+			selected[i] = true
+		}
+	}
+}
+
+// [0, 1, ..., n-1]
+func firstIndexes(n int) []int {
+	indexes := make([]int, n)
+	Iota(indexes, 0)
+	return indexes
+}
@@ -0,0 +1,123 @@
+package algorithm
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func collect[T any](iterator func(yield func([]T) bool)) []string {
+	result := []string{}
+	iterator(func(values []T) bool {
+		result = append(result, fmt.Sprint(values))
+		return true
+	})
+	return result
+}
+
+func TestNextPermutation(t *testing.T) {
+	slice := []int{1, 2, 2, 3}
+	expected := []string{
+		"[1 2 2 3]", "[1 2 3 2]", "[1 3 2 2]", "[2 1 2 3]", "[2 1 3 2]", "[2 2 1 3]",
+		"[2 2 3 1]", "[2 3 1 2]", "[2 3 2 1]", "[3 1 2 2]", "[3 2 1 2]", "[3 2 2 1]",
+	}
+
+	result := []string{fmt.Sprint(slice)}
+	for NextPermutation(slice, Less[int]) {
+		result = append(result, fmt.Sprint(slice))
+	}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("NextPermutation: expected %v got %v", expected, result)
+	}
+	if fmt.Sprint(slice) != "[1 2 2 3]" {
+		t.Fatalf("NextPermutation: the slice should be sorted after the last permutation, got %v", slice)
+	}
+
+	slice = []int{3, 2, 2, 1}
+	result = []string{fmt.Sprint(slice)}
+	for PrevPermutation(slice, Less[int]) {
+		result = append(result, fmt.Sprint(slice))
+	}
+	for i := range result {
+		if result[i] != expected[len(expected)-1-i] {
+			t.Fatalf("PrevPermutation: expected %v got %v", expected[len(expected)-1-i], result[i])
+		}
+	}
+
+	if NextPermutation([]int{}, Less[int]) {
+		t.Fatalf("NextPermutation on an empty slice should return false")
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	result := collect(Permutations([]string{"a", "b", "c"}))
+	expected := []string{"[a b c]", "[a c b]", "[b a c]", "[b c a]", "[c a b]", "[c b a]"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := collect(Permutations([]int{1, 1})); len(result) != 2 {
+		t.Fatalf("equal elements are distinct by position, expected %d permutations got %d", 2, len(result))
+	}
+	if result := collect(Permutations([]int{})); !SliceEqual_(result, []string{"[]"}) {
+		t.Fatalf("expected %v got %v", []string{"[]"}, result)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	result := collect(Combinations([]int{1, 2, 3, 4}, 2))
+	expected := []string{"[1 2]", "[1 3]", "[1 4]", "[2 3]", "[2 4]", "[3 4]"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := collect(Combinations([]int{1, 2}, 0)); !SliceEqual_(result, []string{"[]"}) {
+		t.Fatalf("expected %v got %v", []string{"[]"}, result)
+	}
+	if result := collect(Combinations([]int{1, 2}, 3)); len(result) != 0 {
+		t.Fatalf("expected no combination got %v", result)
+	}
+	if result := collect(Combinations(firstIndexes(10), 5)); len(result) != 252 {
+		t.Fatalf("expected %d combinations got %d", 252, len(result))
+	}
+}
+
+func TestCombinationsWithRepetition(t *testing.T) {
+	result := collect(CombinationsWithRepetition([]int{1, 2, 3}, 2))
+	expected := []string{"[1 1]", "[1 2]", "[1 3]", "[2 2]", "[2 3]", "[3 3]"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := collect(CombinationsWithRepetition([]int{}, 2)); len(result) != 0 {
+		t.Fatalf("expected no combination got %v", result)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	result := collect(CartesianProduct([]int{1, 2}, []int{3}, []int{4, 5}))
+	expected := []string{"[1 3 4]", "[1 3 5]", "[2 3 4]", "[2 3 5]"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	if result := collect(CartesianProduct([]int{1, 2}, []int{})); len(result) != 0 {
+		t.Fatalf("expected no tuple got %v", result)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	result := collect(PowerSet([]int{1, 2, 3}))
+	expected := []string{"[]", "[1]", "[2]", "[1 2]", "[3]", "[1 3]", "[2 3]", "[1 2 3]"}
+	if !SliceEqual_(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+}
+
+func TestIteratorEarlyStop(t *testing.T) {
+	count := 0
+	Permutations(firstIndexes(20))(func([]int) bool {
+		count++
+		return count < 5
+	})
+	if count != 5 {
+		t.Fatalf("expected %d calls got %d", 5, count)
+	}
+}
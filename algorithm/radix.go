@@ -0,0 +1,194 @@
+package algorithm
+
+import (
+	"unsafe"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Non comparison sorts, all of them are stable and use a buffer of the size of the slice
+
+const (
+	radixBits    = 8
+	radixBuckets = 1 << radixBits
+
+	countingSortMinRange = 1 << 16 // CountingSort always counts ranges up to that size
+	stringRadixThreshold = 32      // MSD buckets smaller than that are sorted with insertion sort
+)
+
+// LSD radix sort on bytes, O(n * sizeof(T))
+func RadixSort[T IntegerType](slice []T) {
+	radixSort[T, struct{}](slice, nil)
+}
+
+// key is called once per element
+func RadixSortByKey[T any, K IntegerType](slice []T, key func(T) K) {
+	keys := make([]K, len(slice))
+	for i, a := range slice {
+		keys[i] = key(a)
+	}
+	radixSort(keys, slice)
+}
+
+// Byte of k used by the pass, the sign bit is flipped for the signed types so they are sorted like unsigned ones
+func radixDigit[K IntegerType](k K, pass, passes int) int {
+	digit := int(uint64(k)>>(pass*radixBits)) & (radixBuckets - 1)
+	if pass == passes-1 && ^K(0) < 0 {
+		digit ^= radixBuckets / 2
+	}
+	return digit
+}
+
+// Sort the keys and apply the same permutation to values, values can be nil
+func radixSort[K IntegerType, T any](keys []K, values []T) {
+	n := len(keys)
+	if n <= 1 {
+		return
+	}
+	passes := int(unsafe.Sizeof(K(0)))
+
+	// histograms of every pass computed at once
+	counts := make([][radixBuckets]int, passes)
+	for _, k := range keys {
+		for pass := range counts {
+			counts[pass][radixDigit(k, pass, passes)]++
+		}
+	}
+
+	srcKeys, dstKeys := keys, make([]K, n)
+	srcValues, dstValues := values, []T(nil)
+	if values != nil {
+		dstValues = make([]T, n)
+	}
+	for pass := range counts {
+		count := &counts[pass]
+		// every element has the same digit: the pass would not move anything
+		if count[radixDigit(srcKeys[0], pass, passes)] == n {
+			continue
+		}
+
+		offset := 0
+		for digit := range count {
+			count[digit], offset = offset, offset+count[digit]
+		}
+		for i, k := range srcKeys {
+			digit := radixDigit(k, pass, passes)
+			dstKeys[count[digit]] = k
+			if values != nil {
+				dstValues[count[digit]] = srcValues[i]
+			}
+			count[digit]++
+		}
+		srcKeys, dstKeys = dstKeys, srcKeys
+		srcValues, dstValues = dstValues, srcValues
+	}
+	if &srcKeys[0] != &keys[0] {
+		copy(keys, srcKeys)
+		copy(values, srcValues)
+	}
+}
+
+// Counting sort in O(n + max-min) memory and time
+// Falls back to RadixSort when the range of the values is both wider than 2^16 and than twice the slice
+func CountingSort[T IntegerType](slice []T) {
+	CountingSortByKey(slice, func(a T) T { return a })
+}
+
+func CountingSortByKey[T any, K IntegerType](slice []T, key func(T) K) {
+	n := len(slice)
+	if n <= 1 {
+		return
+	}
+
+	keys := make([]K, n)
+	low, high := key(slice[0]), key(slice[0])
+	for i, a := range slice {
+		keys[i] = key(a)
+		low, high = Min(low, keys[i]), Max(high, keys[i])
+	}
+	// computed on 64 bits: high-low may overflow K
+	span := uint64(high) - uint64(low)
+	if span >= countingSortMinRange && span >= uint64(2*n) {
+		RadixSortByKey(slice, key)
+		return
+	}
+
+	counts := make([]int, span+1)
+	for _, k := range keys {
+		counts[uint64(k)-uint64(low)]++
+	}
+	offset := 0
+	for i := range counts {
+		counts[i], offset = offset, offset+counts[i]
+	}
+
+	sorted := make([]T, n)
+	for i, a := range slice {
+		bucket := uint64(keys[i]) - uint64(low)
+		sorted[counts[bucket]] = a
+		counts[bucket]++
+	}
+	copy(slice, sorted)
+}
+
+// MSD radix sort, the strings are sorted by bytes like with <
+func StringRadixSort[T ~string](slice []T) {
+	StringRadixSortByKey(slice, func(a T) T { return a })
+}
+
+func StringRadixSortByKey[T any, K ~string](slice []T, key func(T) K) {
+	if len(slice) <= 1 {
+		return
+	}
+	stringRadixSort(slice, make([]T, len(slice)), key, 0)
+}
+
+// Sort slice on the bytes of the keys starting at depth, buffer has the size of slice
+func stringRadixSort[T any, K ~string](slice, buffer []T, key func(T) K, depth int) {
+	if len(slice) < stringRadixThreshold {
+		stringInsertionSort(slice, key, depth)
+		return
+	}
+
+	// bucket 0 holds the keys ending before depth, bucket b+1 the ones with the byte b at depth
+	var counts [radixBuckets + 1]int
+	digit := func(a T) int {
+		if k := key(a); depth < len(k) {
+			return int(k[depth]) + 1
+		}
+		return 0
+	}
+	for _, a := range slice {
+		counts[digit(a)]++
+	}
+
+	var offsets [radixBuckets + 1]int
+	offset := 0
+	for d := range counts {
+		offsets[d], offset = offset, offset+counts[d]
+	}
+	for _, a := range slice {
+		d := digit(a)
+		buffer[offsets[d]] = a
+		offsets[d]++
+	}
+	copy(slice, buffer)
+
+	// the keys of bucket 0 are all equal, the other buckets are sorted on the next byte
+	low := counts[0]
+	for d := 1; d < len(counts); d++ {
+		high := low + counts[d]
+		if counts[d] > 1 {
+			stringRadixSort(slice[low:high], buffer[low:high], key, depth+1)
+		}
+		low = high
+	}
+}
+
+func stringInsertionSort[T any, K ~string](slice []T, key func(T) K, depth int) {
+	for i := 1; i < len(slice); i++ {
+		for j := i; j > 0 && key(slice[j])[depth:] < key(slice[j-1])[depth:]; j-- {
+			slice[j], slice[j-1] = slice[j-1], slice[j]
+		}
+	}
+}
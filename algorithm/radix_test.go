@@ -0,0 +1,165 @@
+package algorithm
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func randomSlice[T IntegerType](n int, seed int64) []T {
+	r := rand.New(rand.NewSource(seed))
+	slice := make([]T, n)
+	for i := range slice {
+		slice[i] = T(r.Uint64())
+	}
+	return slice
+}
+
+func checkIntegerSort[T IntegerType](t *testing.T, name string, sortFunc func([]T), slice []T) {
+	expected := append([]T{}, slice...)
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+	sortFunc(slice)
+	if !SliceEqual_(slice, expected) {
+		t.Fatalf("%s: the slice is not sorted", name)
+	}
+}
+
+func TestRadixSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 100, 10000} {
+		checkIntegerSort(t, "int8", RadixSort[int8], randomSlice[int8](n, 1))
+		checkIntegerSort(t, "uint16", RadixSort[uint16], randomSlice[uint16](n, 2))
+		checkIntegerSort(t, "int32", RadixSort[int32], randomSlice[int32](n, 3))
+		checkIntegerSort(t, "int64", RadixSort[int64], randomSlice[int64](n, 4))
+		checkIntegerSort(t, "uint64", RadixSort[uint64], randomSlice[uint64](n, 5))
+		checkIntegerSort(t, "int", RadixSort[int], randomInts(n, 6))
+	}
+	checkIntegerSort(t, "limits", RadixSort[int64], []int64{0, math.MaxInt64, -1, math.MinInt64, 1, math.MinInt64 + 1})
+}
+
+func TestCountingSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 100, 10000} {
+		checkIntegerSort(t, "small range", CountingSort[int], randomInts(n, 7))
+		checkIntegerSort(t, "int8", CountingSort[int8], randomSlice[int8](n, 8))
+		// falls back to RadixSort
+		checkIntegerSort(t, "wide range", CountingSort[int64], randomSlice[int64](n, 9))
+	}
+	checkIntegerSort(t, "limits", CountingSort[int64], []int64{math.MaxInt64, math.MinInt64, 0})
+}
+
+type keyed struct {
+	key   int32
+	index int
+}
+
+func TestSortByKeyStable(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	input := make([]keyed, 5000)
+	for i := range input {
+		input[i] = keyed{key: int32(r.Intn(100) - 50), index: i}
+	}
+	expected := append([]keyed{}, input...)
+	sort.SliceStable(expected, func(i, j int) bool { return expected[i].key < expected[j].key })
+
+	key := func(k keyed) int32 { return k.key }
+	for name, sortFunc := range map[string]func([]keyed){
+		"RadixSortByKey":    func(s []keyed) { RadixSortByKey(s, key) },
+		"CountingSortByKey": func(s []keyed) { CountingSortByKey(s, key) },
+	} {
+		slice := append([]keyed{}, input...)
+		sortFunc(slice)
+		if !SliceEqual_(slice, expected) {
+			t.Fatalf("%s: the slice is not stably sorted", name)
+		}
+	}
+}
+
+func randomStrings(n int, seed int64) []string {
+	r := rand.New(rand.NewSource(seed))
+	slice := make([]string, n)
+	for i := range slice {
+		// few letters and shared prefixes to get deep buckets
+		b := strings.Builder{}
+		for l := r.Intn(12); l > 0; l-- {
+			b.WriteByte("abc\x00\xff"[r.Intn(5)])
+		}
+		slice[i] = b.String()
+	}
+	return slice
+}
+
+func TestStringRadixSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 31, 100, 10000} {
+		slice := randomStrings(n, int64(n))
+		expected := append([]string{}, slice...)
+		sort.Strings(expected)
+		StringRadixSort(slice)
+		if !SliceEqual_(slice, expected) {
+			t.Fatalf("n %d: the slice is not sorted", n)
+		}
+	}
+
+	type named struct {
+		name  string
+		index int
+	}
+	input := []named{{"b", 0}, {"a", 1}, {"b", 2}, {"", 3}, {"ab", 4}, {"a", 5}}
+	StringRadixSortByKey(input, func(n named) string { return n.name })
+	expected := []named{{"", 3}, {"a", 1}, {"a", 5}, {"ab", 4}, {"b", 0}, {"b", 2}}
+	if !SliceEqual_(input, expected) {
+		t.Fatalf("StringRadixSortByKey: expected %v got %v", expected, input)
+	}
+}
+
+func benchmarkIntSort(b *testing.B, n int, sortFunc func([]int64)) {
+	input := randomSlice[int64](n, 42)
+	slice := make([]int64, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(slice, input)
+		sortFunc(slice)
+	}
+}
+
+func BenchmarkSortInt64_1M(b *testing.B) {
+	benchmarkIntSort(b, 1_000_000, func(s []int64) { Sort(s, Less[int64]) })
+}
+func BenchmarkRadixSortInt64_1M(b *testing.B) { benchmarkIntSort(b, 1_000_000, RadixSort[int64]) }
+
+func BenchmarkSortSmallRange_1M(b *testing.B) {
+	input := randomInts(1_000_000, 42)
+	slice := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(slice, input)
+		Sort(slice, Less[int])
+	}
+}
+
+func BenchmarkCountingSortSmallRange_1M(b *testing.B) {
+	input := randomInts(1_000_000, 42)
+	slice := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(slice, input)
+		CountingSort(slice)
+	}
+}
+
+func benchmarkStringSort(b *testing.B, sortFunc func([]string)) {
+	input := randomStrings(200_000, 42)
+	slice := make([]string, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(slice, input)
+		sortFunc(slice)
+	}
+}
+
+func BenchmarkSortStrings(b *testing.B) {
+	benchmarkStringSort(b, func(s []string) { Sort(s, Less[string]) })
+}
+func BenchmarkStringRadixSort(b *testing.B) { benchmarkStringSort(b, StringRadixSort[string]) }
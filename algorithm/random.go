@@ -0,0 +1,173 @@
+package algorithm
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// All the functions below take the source of randomness as parameter to be reproducible
+// On nil, a source seeded with the current time is used
+
+func newRand(source rand.Source) *rand.Rand {
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+	return rand.New(source)
+}
+
+// Fisher-Yates shuffle, in place
+func Shuffle[T any](slice []T, source rand.Source) {
+	r := newRand(source)
+	for i := len(slice) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// k elements taken at distinct positions of the slice, in random order
+// Returns a new slice of min(k, len(slice)) elements, O(k) time and memory
+func Sample[T any](slice []T, k int, source rand.Source) []T {
+	k = Min(Max(k, 0), len(slice))
+	r := newRand(source)
+
+	// partial Fisher-Yates on the positions, only the swapped ones are stored
+	swapped := make(map[int]int, k)
+	position := func(i int) int {
+		if p, ok := swapped[i]; ok {
+			return p
+		}
+		return i
+	}
+
+	result := make([]T, k)
+	n := len(slice)
+	for i := 0; i < k; i++ {
+		j := i + r.Intn(n-i)
+		pi, pj := position(i), position(j)
+		swapped[i], swapped[j] = pj, pi
+		result[i] = slice[pj]
+	}
+	return result
+}
+
+var ErrInvalidWeights = errors.New("weights must be non negative, with a positive sum, one per value")
+
+// Weighted random choice in O(1) per pick (Vose's alias method)
+type AliasTable[T any] interface {
+	Pick() T
+}
+
+func NewAliasTable[T any](values []T, weights []float64, source rand.Source) (AliasTable[T], error) {
+	n := len(values)
+	if n == 0 || len(weights) != n {
+		return nil, ErrInvalidWeights
+	}
+	total := 0.
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) {
+			return nil, ErrInvalidWeights
+		}
+		total += w
+	}
+	if total <= 0 {
+		return nil, ErrInvalidWeights
+	}
+
+	table := &aliasTable[T]{
+		values: append([]T{}, values...),
+		prob:   make([]float64, n),
+		alias:  make([]int, n),
+		r:      newRand(source),
+	}
+
+	// scale the weights so the mean is 1, then pair each small column with a large one
+	scaled := make([]float64, n)
+	small, large := []int{}, []int{}
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+
+		table.prob[s] = scaled[s]
+		table.alias[s] = l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// the remaining columns are full (up to rounding errors)
+	for _, i := range append(small, large...) {
+		table.prob[i] = 1
+	}
+	return table, nil
+}
+
+type aliasTable[T any] struct {
+	values []T
+	prob   []float64 // probability to keep the column instead of taking its alias
+	alias  []int
+	r      *rand.Rand
+}
+
+func (this *aliasTable[T]) Pick() T {
+	i := this.r.Intn(len(this.values))
+	if this.r.Float64() < this.prob[i] {
+		return this.values[i]
+	}
+	return this.values[this.alias[i]]
+}
+
+// Uniform sample of k values of a stream of unknown size, in O(k) memory (Algorithm R)
+// Returns less than k values if the channel is closed before k values were received
+func ReservoirSample[T any](input <-chan T, k int, source rand.Source) []T {
+	reservoir := newReservoir[T](k, source)
+	for value := range input {
+		reservoir.add(value)
+	}
+	return reservoir.values
+}
+
+// Same as ReservoirSample on an iterator
+func ReservoirSampleIter[T any](iterator func(yield func(T) bool), k int, source rand.Source) []T {
+	reservoir := newReservoir[T](k, source)
+	iterator(func(value T) bool {
+		reservoir.add(value)
+		return true
+	})
+	return reservoir.values
+}
+
+type reservoir[T any] struct {
+	k      int
+	seen   int
+	values []T
+	r      *rand.Rand
+}
+
+func newReservoir[T any](k int, source rand.Source) *reservoir[T] {
+	k = Max(k, 0)
+	return &reservoir[T]{
+		k:      k,
+		values: make([]T, 0, k),
+		r:      newRand(source),
+	}
+}
+
+func (this *reservoir[T]) add(value T) {
+	this.seen++
+	if len(this.values) < this.k {
+		this.values = append(this.values, value)
+	} else if j := this.r.Intn(this.seen); j < this.k {
+		this.values[j] = value
+	}
+}
@@ -0,0 +1,156 @@
+package algorithm
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	slice := firstIndexes(100)
+	Shuffle(slice, rand.NewSource(1))
+
+	if SliceEqual_(slice, firstIndexes(100)) {
+		t.Fatalf("the slice has not been shuffled")
+	}
+	sorted := append([]int{}, slice...)
+	sort.Ints(sorted)
+	if !SliceEqual_(sorted, firstIndexes(100)) {
+		t.Fatalf("elements have been lost: %v", slice)
+	}
+
+	// same source, same result
+	again := firstIndexes(100)
+	Shuffle(again, rand.NewSource(1))
+	if !SliceEqual_(slice, again) {
+		t.Fatalf("the shuffle is not reproducible")
+	}
+
+	Shuffle([]int{}, nil)
+}
+
+func TestShuffleUniform(t *testing.T) {
+	// each of the 6 permutations of 3 elements should appear about 1/6 of the time
+	r := rand.NewSource(2)
+	counts := map[[3]int]int{}
+	n := 60000
+	for i := 0; i < n; i++ {
+		slice := []int{0, 1, 2}
+		Shuffle(slice, r)
+		counts[[3]int{slice[0], slice[1], slice[2]}]++
+	}
+	if len(counts) != 6 {
+		t.Fatalf("expected %d permutations got %d", 6, len(counts))
+	}
+	for permutation, count := range counts {
+		if math.Abs(float64(count)-float64(n)/6) > float64(n)/60 {
+			t.Fatalf("permutation %v appears %d times on %d", permutation, count, n)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	slice := firstIndexes(1000)
+	for _, k := range []int{-1, 0, 1, 10, 999, 1000, 2000} {
+		sample := Sample(slice, k, rand.NewSource(int64(k)))
+		if expected := Min(Max(k, 0), len(slice)); len(sample) != expected {
+			t.Fatalf("Sample(%d): expected %d elements got %d", k, expected, len(sample))
+		}
+		if !Unique_(sample) {
+			t.Fatalf("Sample(%d): the same position has been taken twice", k)
+		}
+	}
+	if !SliceEqual_(slice, firstIndexes(1000)) {
+		t.Fatalf("the input has been modified")
+	}
+
+	// every element should be taken about k/n of the time
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		for _, a := range Sample(firstIndexes(10), 3, rand.NewSource(int64(i))) {
+			counts[a]++
+		}
+	}
+	for i, count := range counts {
+		if math.Abs(float64(count)-3000) > 300 {
+			t.Fatalf("element %d has been taken %d times", i, count)
+		}
+	}
+}
+
+func TestAliasTable(t *testing.T) {
+	values := []string{"a", "b", "c", "d"}
+	weights := []float64{1, 2, 7, 0}
+	table, err := NewAliasTable(values, weights, rand.NewSource(3))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	counts := map[string]int{}
+	n := 100000
+	for i := 0; i < n; i++ {
+		counts[table.Pick()]++
+	}
+	for i, value := range values {
+		expected := float64(n) * weights[i] / 10
+		if math.Abs(float64(counts[value])-expected) > float64(n)/100 {
+			t.Fatalf("%s has been picked %d times, expected about %v", value, counts[value], expected)
+		}
+	}
+
+	invalids := []struct {
+		values  []string
+		weights []float64
+	}{
+		{[]string{}, []float64{}},
+		{[]string{"a"}, []float64{1, 2}},
+		{[]string{"a", "b"}, []float64{1, -1}},
+		{[]string{"a", "b"}, []float64{0, 0}},
+		{[]string{"a"}, []float64{math.NaN()}},
+	}
+	for _, invalid := range invalids {
+		if _, err := NewAliasTable(invalid.values, invalid.weights, nil); err != ErrInvalidWeights {
+			t.Fatalf("NewAliasTable(%v, %v): expected %v got %v", invalid.values, invalid.weights, ErrInvalidWeights, err)
+		}
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	input := make(chan int)
+	go func() {
+		for i := 0; i < 1000; i++ {
+			input <- i
+		}
+		close(input)
+	}()
+	sample := ReservoirSample(input, 10, rand.NewSource(4))
+	if len(sample) != 10 || !Unique_(sample) {
+		t.Fatalf("expected %d distinct values got %v", 10, sample)
+	}
+
+	short := ReservoirSampleIter(func(yield func(int) bool) { yield(1) }, 5, nil)
+	if !SliceEqual_(short, []int{1}) {
+		t.Fatalf("expected %v got %v", []int{1}, short)
+	}
+
+	// every value should be kept about k/n of the time
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		iterator := func(yield func(int) bool) {
+			for j := 0; j < 10; j++ {
+				if !yield(j) {
+					return
+				}
+			}
+		}
+		for _, a := range ReservoirSampleIter(iterator, 3, rand.NewSource(int64(i))) {
+			counts[a]++
+		}
+	}
+	for i, count := range counts {
+		if math.Abs(float64(count)-3000) > 300 {
+			t.Fatalf("value %d has been kept %d times", i, count)
+		}
+	}
+}
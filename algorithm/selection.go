@@ -0,0 +1,145 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+)
+
+// Rearrange the slice so slice[n] is the element that would be there if the slice was sorted,
+// the elements before are not greater and the ones after are not less (introselect, O(n) on average)
+func NthElement[T any](slice []T, n int, comp Ordf[T]) {
+	if n < 0 || n >= len(slice) {
+		return
+	}
+
+	a, b := 0, len(slice)
+	limit := 2 * sortLimit(len(slice))
+	for b-a > insertionSortThreshold {
+		if limit == 0 {
+			heapSort(slice, comp, a, b)
+			return
+		}
+		limit--
+
+		pivot, _ := choosePivot(slice, comp, a, b)
+		// same trick as pdqsort: the pivot is the minimum of the range, skip its duplicates
+		if a > 0 && !comp(slice[a-1], slice[pivot]) {
+			a = partitionEqual(slice, comp, a, b, pivot)
+			if n < a {
+				return
+			}
+			continue
+		}
+
+		mid, _ := partition(slice, comp, a, b, pivot)
+		if mid == n {
+			return
+		} else if n < mid {
+			b = mid
+		} else {
+			a = mid + 1
+		}
+	}
+	insertionSort(slice, comp, a, b)
+}
+
+// Sort the k smallest elements at the beginning of the slice, the order of the others is unspecified
+func PartialSort[T any](slice []T, k int, comp Ordf[T]) {
+	k = Min(Max(k, 0), len(slice))
+	if k == 0 {
+		return
+	}
+
+	// max-heap of the k smallest elements seen so far
+	heap := slice[:k]
+	for i := (k - 1) / 2; i >= 0; i-- {
+		siftDown(heap, comp, i, k)
+	}
+	for i := k; i < len(slice); i++ {
+		if comp(slice[i], heap[0]) {
+			heap[0], slice[i] = slice[i], heap[0]
+			siftDown(heap, comp, 0, k)
+		}
+	}
+	for end := k - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDown(heap, comp, 0, end)
+	}
+}
+
+// Copy the min(len(src), len(dst)) smallest elements of src, sorted, in dst
+// src is left unchanged, returns the number of copied elements
+func PartialSortCopy[T any](src, dst []T, comp Ordf[T]) int {
+	k := Min(len(src), len(dst))
+	if k == 0 {
+		return 0
+	}
+
+	heap := dst[:k]
+	copy(heap, src[:k])
+	for i := (k - 1) / 2; i >= 0; i-- {
+		siftDown(heap, comp, i, k)
+	}
+	for _, a := range src[k:] {
+		if comp(a, heap[0]) {
+			heap[0] = a
+			siftDown(heap, comp, 0, k)
+		}
+	}
+	for end := k - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDown(heap, comp, 0, end)
+	}
+	return k
+}
+
+// Keep the k smallest values (according to comp) of a stream in O(k) memory
+// Use Greater as comp to keep the k biggest ones
+type TopK[T any] interface {
+	Push(T)
+	Size() int
+	// The kept values sorted with comp, the TopK is left unchanged
+	Values() []T
+}
+
+func NewTopK[T any](k int, comp Ordf[T]) TopK[T] {
+	return &topK[T]{
+		k:    k,
+		comp: comp,
+		// the front of the queue is the worst kept value
+		queue: priorityqueue.NewPriorityQueue(func(a, b T) bool { return comp(b, a) }),
+	}
+}
+
+type topK[T any] struct {
+	k     int
+	comp  Ordf[T]
+	queue priorityqueue.PriorityQueue[T]
+}
+
+func (this *topK[T]) Push(value T) {
+	if this.k <= 0 {
+		return
+	}
+	if this.queue.Size() < this.k {
+		this.queue.Push(value)
+	} else if this.comp(value, this.queue.Front()) {
+		this.queue.Pop()
+		this.queue.Push(value)
+	}
+}
+
+func (this *topK[T]) Size() int { return this.queue.Size() }
+
+func (this *topK[T]) Values() []T {
+	values := make([]T, 0, this.queue.Size())
+	for !this.queue.Empty() {
+		values = append(values, this.queue.Front())
+		this.queue.Pop()
+	}
+	for _, value := range values {
+		this.queue.Push(value)
+	}
+	reverseRange(values, 0, len(values))
+	return values
+}
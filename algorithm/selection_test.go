@@ -0,0 +1,115 @@
+package algorithm
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestNthElement(t *testing.T) {
+	for _, n := range []int{1, 5, 13, 100, 1000, 10000} {
+		for name, input := range sortInputs(n) {
+			sorted := append([]int{}, input...)
+			sort.Ints(sorted)
+
+			for _, nth := range []int{0, n / 3, n / 2, n - 1} {
+				slice := append([]int{}, input...)
+				NthElement(slice, nth, Less[int])
+
+				if slice[nth] != sorted[nth] {
+					t.Fatalf("%s (%d): slice[%d]: expected %d got %d", name, n, nth, sorted[nth], slice[nth])
+				}
+				for i := 0; i < nth; i++ {
+					if slice[i] > slice[nth] {
+						t.Fatalf("%s (%d): slice[%d] = %d is greater than the nth element %d", name, n, i, slice[i], slice[nth])
+					}
+				}
+				for i := nth + 1; i < n; i++ {
+					if slice[i] < slice[nth] {
+						t.Fatalf("%s (%d): slice[%d] = %d is less than the nth element %d", name, n, i, slice[i], slice[nth])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	input := randomInts(1000, 8)
+	sorted := append([]int{}, input...)
+	sort.Ints(sorted)
+
+	for _, k := range []int{-1, 0, 1, 10, 999, 1000, 2000} {
+		slice := append([]int{}, input...)
+		PartialSort(slice, k, Less[int])
+
+		k = Min(Max(k, 0), len(slice))
+		if !SliceEqual_(slice[:k], sorted[:k]) {
+			t.Fatalf("PartialSort(%d): the first elements are not the smallest ones", k)
+		}
+		rest := append([]int{}, slice[k:]...)
+		sort.Ints(rest)
+		if !SliceEqual_(rest, sorted[k:]) {
+			t.Fatalf("PartialSort(%d): elements have been lost", k)
+		}
+	}
+}
+
+func TestPartialSortCopy(t *testing.T) {
+	src := randomInts(1000, 9)
+	original := append([]int{}, src...)
+	sorted := append([]int{}, src...)
+	sort.Ints(sorted)
+
+	dst := make([]int, 10)
+	if n := PartialSortCopy(src, dst, Less[int]); n != 10 {
+		t.Fatalf("expected %d copied elements got %d", 10, n)
+	}
+	if !SliceEqual_(dst, sorted[:10]) {
+		t.Fatalf("expected %v got %v", sorted[:10], dst)
+	}
+	if !SliceEqual_(src, original) {
+		t.Fatalf("the source has been modified")
+	}
+
+	dst = make([]int, 5)
+	if n := PartialSortCopy([]int{3, 1, 2}, dst, Less[int]); n != 3 || !SliceEqual_(dst[:n], []int{1, 2, 3}) {
+		t.Fatalf("expected %v got %v", []int{1, 2, 3}, dst[:n])
+	}
+}
+
+func TestTopK(t *testing.T) {
+	input := randomInts(10000, 10)
+	sorted := append([]int{}, input...)
+	sort.Ints(sorted)
+
+	top := NewTopK(100, Greater[int])
+	for _, i := range input {
+		top.Push(i)
+	}
+	if top.Size() != 100 {
+		t.Fatalf("expected %d values got %d", 100, top.Size())
+	}
+	values := top.Values()
+	for i, value := range values {
+		if expected := sorted[len(sorted)-1-i]; value != expected {
+			t.Fatalf("%d: expected %d got %d", i, expected, value)
+		}
+	}
+	if !SliceEqual_(top.Values(), values) {
+		t.Fatalf("Values should not modify the TopK")
+	}
+
+	small := NewTopK(10, Less[int])
+	small.Push(3)
+	small.Push(1)
+	if values := small.Values(); !SliceEqual_(values, []int{1, 3}) {
+		t.Fatalf("expected %v got %v", []int{1, 3}, values)
+	}
+	empty := NewTopK(0, Less[int])
+	empty.Push(1)
+	if empty.Size() != 0 {
+		t.Fatalf("expected %d values got %d", 0, empty.Size())
+	}
+}
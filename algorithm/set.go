@@ -0,0 +1,138 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+)
+
+// All the set operations take slices sorted with comp and return a new sorted slice
+// Duplicates are handled like multisets (same semantics as the C++ STL)
+
+// Elements present in a or b, an element present m times in a and n times in b is kept max(m, n) times
+func SetUnion[T any](a, b []T, comp Ordf[T]) []T {
+	result := make([]T, 0, Max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			result = append(result, a[i])
+			i++
+		} else if comp(b[j], a[i]) {
+			result = append(result, b[j])
+			j++
+		} else {
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
+// Elements present in both a and b, taken from a
+func SetIntersection[T any](a, b []T, comp Ordf[T]) []T {
+	result := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			i++
+		} else if comp(b[j], a[i]) {
+			j++
+		} else {
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Elements of a not present in b
+func SetDifference[T any](a, b []T, comp Ordf[T]) []T {
+	result := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			result = append(result, a[i])
+			i++
+		} else if comp(b[j], a[i]) {
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	return append(result, a[i:]...)
+}
+
+// Elements present in a or b but not in both
+func SetSymmetricDifference[T any](a, b []T, comp Ordf[T]) []T {
+	result := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			result = append(result, a[i])
+			i++
+		} else if comp(b[j], a[i]) {
+			result = append(result, b[j])
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
+// True if every element of b is present in a
+func Includes[T any](a, b []T, comp Ordf[T]) bool {
+	i := 0
+	for _, x := range b {
+		for i < len(a) && comp(a[i], x) {
+			i++
+		}
+		if i == len(a) || comp(x, a[i]) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+type mergeCursor[T any] struct {
+	value T
+	slice int // index of the slice in the input
+	pos   int // index of the value in its slice
+}
+
+// Merge k sorted slices in O(n log k), on equality the elements of the first slices come first
+func MergeK[T any](slices [][]T, comp Ordf[T]) []T {
+	size := 0
+	queue := priorityqueue.NewPriorityQueue(func(a, b mergeCursor[T]) bool {
+		if comp(a.value, b.value) {
+			return true
+		}
+		if comp(b.value, a.value) {
+			return false
+		}
+		return a.slice < b.slice
+	})
+	for i, slice := range slices {
+		size += len(slice)
+		if len(slice) > 0 {
+			queue.Push(mergeCursor[T]{value: slice[0], slice: i})
+		}
+	}
+
+	result := make([]T, 0, size)
+	for !queue.Empty() {
+		cursor := queue.Front()
+		queue.Pop()
+		result = append(result, cursor.value)
+		if next := cursor.pos + 1; next < len(slices[cursor.slice]) {
+			queue.Push(mergeCursor[T]{value: slices[cursor.slice][next], slice: cursor.slice, pos: next})
+		}
+	}
+	return result
+}
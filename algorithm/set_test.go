@@ -0,0 +1,101 @@
+package algorithm
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		a, b                                     []int
+		union, intersection, difference, symDiff []int
+	}{
+		{
+			a: []int{1, 2, 3, 4, 5}, b: []int{3, 4, 5, 6, 7},
+			union:        []int{1, 2, 3, 4, 5, 6, 7},
+			intersection: []int{3, 4, 5},
+			difference:   []int{1, 2},
+			symDiff:      []int{1, 2, 6, 7},
+		},
+		{
+			a: []int{1, 1, 2, 2, 2, 3}, b: []int{1, 2, 4, 4},
+			union:        []int{1, 1, 2, 2, 2, 3, 4, 4},
+			intersection: []int{1, 2},
+			difference:   []int{1, 2, 2, 3},
+			symDiff:      []int{1, 2, 2, 3, 4, 4},
+		},
+		{
+			a: []int{}, b: []int{1, 2},
+			union:        []int{1, 2},
+			intersection: []int{},
+			difference:   []int{},
+			symDiff:      []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		if result := SetUnion(test.a, test.b, Less[int]); !SliceEqual_(result, test.union) {
+			t.Fatalf("SetUnion(%v, %v): expected %v got %v", test.a, test.b, test.union, result)
+		}
+		if result := SetIntersection(test.a, test.b, Less[int]); !SliceEqual_(result, test.intersection) {
+			t.Fatalf("SetIntersection(%v, %v): expected %v got %v", test.a, test.b, test.intersection, result)
+		}
+		if result := SetDifference(test.a, test.b, Less[int]); !SliceEqual_(result, test.difference) {
+			t.Fatalf("SetDifference(%v, %v): expected %v got %v", test.a, test.b, test.difference, result)
+		}
+		if result := SetSymmetricDifference(test.a, test.b, Less[int]); !SliceEqual_(result, test.symDiff) {
+			t.Fatalf("SetSymmetricDifference(%v, %v): expected %v got %v", test.a, test.b, test.symDiff, result)
+		}
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	tests := []struct {
+		a, b     []int
+		expected bool
+	}{
+		{[]int{1, 2, 3, 4}, []int{2, 4}, true},
+		{[]int{1, 2, 3, 4}, []int{}, true},
+		{[]int{}, []int{1}, false},
+		{[]int{1, 2, 3, 4}, []int{2, 5}, false},
+		{[]int{1, 2, 3}, []int{2, 2}, false},
+		{[]int{1, 2, 2, 3}, []int{2, 2}, true},
+	}
+	for _, test := range tests {
+		if result := Includes(test.a, test.b, Less[int]); result != test.expected {
+			t.Fatalf("Includes(%v, %v): expected %v got %v", test.a, test.b, test.expected, result)
+		}
+	}
+}
+
+func TestMergeK(t *testing.T) {
+	slices := [][]int{}
+	expected := []int{}
+	for i := 0; i < 10; i++ {
+		slice := randomInts(i*37, int64(i))
+		sort.Ints(slice)
+		slices = append(slices, slice)
+		expected = append(expected, slice...)
+	}
+	sort.Ints(expected)
+
+	if result := MergeK(slices, Less[int]); !SliceEqual_(result, expected) {
+		t.Fatalf("MergeK: the result is not sorted")
+	}
+	if result := MergeK([][]int{}, Less[int]); len(result) != 0 {
+		t.Fatalf("MergeK: expected an empty slice got %v", result)
+	}
+
+	// on equality, the elements of the first slices come first
+	stable := MergeK([][]keyIndex{{{1, 0}, {2, 1}}, {{1, 2}}, {{0, 3}, {2, 4}}}, lessKey)
+	if len(stable) != 5 {
+		t.Fatalf("MergeK: expected %d elements got %d", 5, len(stable))
+	}
+	for i := 1; i < len(stable); i++ {
+		if stable[i-1].key == stable[i].key && stable[i-1].index > stable[i].index {
+			t.Fatalf("MergeK: %v is not stable", stable)
+		}
+	}
+}
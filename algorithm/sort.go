@@ -0,0 +1,330 @@
+package algorithm
+
+import (
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Pattern-defeating quicksort (Orson Peters) on slice[a:b]
+// comp must be a strict weak ordering (e.g. Less): comp(x, x) must be false
+
+const (
+	insertionSortThreshold = 12 // ranges smaller than that are sorted with insertion sort
+	nintherThreshold       = 50 // ranges bigger than that use the median of three medians as pivot
+	partialInsertionSteps  = 5  // maximum number of misplaced elements fixed by partialInsertionSort
+	partialInsertionMinLen = 50
+)
+
+type sortHint int
+
+const (
+	unknownHint sortHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// limit: number of unbalanced partitions allowed before falling back to heap sort
+func pdqsort[T any](slice []T, comp Ordf[T], a, b, limit int) {
+	wasBalanced := true
+	wasPartitioned := true
+
+	for {
+		length := b - a
+		if length <= insertionSortThreshold {
+			insertionSort(slice, comp, a, b)
+			return
+		}
+		if limit == 0 {
+			heapSort(slice, comp, a, b)
+			return
+		}
+		if !wasBalanced {
+			breakPatterns(slice, a, b)
+			limit--
+		}
+
+		pivot, hint := choosePivot(slice, comp, a, b)
+		if hint == decreasingHint {
+			reverseRange(slice, a, b)
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+		// the range looks sorted, try to finish it with a few insertions
+		if wasBalanced && wasPartitioned && hint == increasingHint && partialInsertionSort(slice, comp, a, b) {
+			return
+		}
+
+		// the element before the range is not smaller than the pivot: as it is also greater or equal
+		// than every element of the range, the pivot is the minimum and we only need to skip its duplicates
+		if a > 0 && !comp(slice[a-1], slice[pivot]) {
+			a = partitionEqual(slice, comp, a, b, pivot)
+			continue
+		}
+
+		mid, alreadyPartitioned := partition(slice, comp, a, b, pivot)
+		wasPartitioned = alreadyPartitioned
+
+		// recurse on the smallest side to bound the stack to O(log n)
+		leftLen, rightLen := mid-a, b-mid
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= length/8
+			pdqsort(slice, comp, a, mid, limit)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= length/8
+			pdqsort(slice, comp, mid+1, b, limit)
+			b = mid
+		}
+	}
+}
+
+func insertionSort[T any](slice []T, comp Ordf[T], a, b int) {
+	for i := a + 1; i < b; i++ {
+		for j := i; j > a && comp(slice[j], slice[j-1]); j-- {
+			slice[j], slice[j-1] = slice[j-1], slice[j]
+		}
+	}
+}
+
+func heapSort[T any](slice []T, comp Ordf[T], a, b int) {
+	MakeHeap(slice[a:b], comp)
+	SortHeap(slice[a:b], comp)
+}
+
+// max-heap on heap[:end]
+func siftDown[T any](heap []T, comp Ordf[T], root, end int) {
+	for {
+		child := 2*root + 1
+		if child >= end {
+			return
+		}
+		if child+1 < end && comp(heap[child], heap[child+1]) {
+			child++
+		}
+		if !comp(heap[root], heap[child]) {
+			return
+		}
+		heap[root], heap[child] = heap[child], heap[root]
+		root = child
+	}
+}
+
+// Median of three on small ranges, median of three medians on big ones
+// The hint is computed from the number of swaps the medians would need
+func choosePivot[T any](slice []T, comp Ordf[T], a, b int) (int, sortHint) {
+	const maxSwaps = 4 * 3
+
+	length := b - a
+	swaps := 0
+	i, j, k := a+length/4, a+length/4*2, a+length/4*3
+
+	if length >= nintherThreshold {
+		i = median3(slice, comp, i-1, i, i+1, &swaps)
+		j = median3(slice, comp, j-1, j, j+1, &swaps)
+		k = median3(slice, comp, k-1, k, k+1, &swaps)
+	}
+	j = median3(slice, comp, i, j, k, &swaps)
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// Returns the index of the median of slice[a], slice[b] and slice[c] without moving them
+func median3[T any](slice []T, comp Ordf[T], a, b, c int, swaps *int) int {
+	if comp(slice[b], slice[a]) {
+		*swaps++
+		a, b = b, a
+	}
+	if comp(slice[c], slice[b]) {
+		*swaps++
+		b, c = c, b
+	}
+	if comp(slice[b], slice[a]) {
+		*swaps++
+		a, b = b, a
+	}
+	return b
+}
+
+// Hoare partition around slice[pivot], returns the final position of the pivot
+// alreadyPartitioned is true when no element had to be swapped
+func partition[T any](slice []T, comp Ordf[T], a, b, pivot int) (int, bool) {
+	slice[a], slice[pivot] = slice[pivot], slice[a]
+	i, j := a+1, b-1
+
+	for i <= j && comp(slice[i], slice[a]) {
+		i++
+	}
+	for i <= j && !comp(slice[j], slice[a]) {
+		j--
+	}
+	if i > j {
+		slice[j], slice[a] = slice[a], slice[j]
+		return j, true
+	}
+	slice[i], slice[j] = slice[j], slice[i]
+	i++
+	j--
+
+	for {
+		for i <= j && comp(slice[i], slice[a]) {
+			i++
+		}
+		for i <= j && !comp(slice[j], slice[a]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		slice[i], slice[j] = slice[j], slice[i]
+		i++
+		j--
+	}
+	slice[j], slice[a] = slice[a], slice[j]
+	return j, false
+}
+
+// Move all the elements equal to slice[pivot] at the beginning of the range
+// and returns the index of the first element greater than the pivot
+func partitionEqual[T any](slice []T, comp Ordf[T], a, b, pivot int) int {
+	slice[a], slice[pivot] = slice[pivot], slice[a]
+	i, j := a+1, b-1
+
+	for {
+		for i <= j && !comp(slice[a], slice[i]) {
+			i++
+		}
+		for i <= j && comp(slice[a], slice[j]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		slice[i], slice[j] = slice[j], slice[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// Try to sort a nearly sorted range by fixing a few misplaced elements
+// Returns false if the range is still not sorted
+func partialInsertionSort[T any](slice []T, comp Ordf[T], a, b int) bool {
+	i := a + 1
+	for step := 0; step < partialInsertionSteps; step++ {
+		for i < b && !comp(slice[i], slice[i-1]) {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < partialInsertionMinLen {
+			return false
+		}
+
+		slice[i], slice[i-1] = slice[i-1], slice[i]
+		// shift the smaller element to the left and the greater one to the right
+		for j := i - 1; j > a && comp(slice[j], slice[j-1]); j-- {
+			slice[j], slice[j-1] = slice[j-1], slice[j]
+		}
+		for j := i + 1; j < b && comp(slice[j], slice[j-1]); j++ {
+			slice[j], slice[j-1] = slice[j-1], slice[j]
+		}
+	}
+	return false
+}
+
+// Swap a few elements around the middle of the range with pseudo random positions
+func breakPatterns[T any](slice []T, a, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+
+	random := xorshift(length)
+	modulus := nextPowerOfTwo(length)
+	idx := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		other := int(uint(random.next()) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		slice[idx+i], slice[a+other] = slice[a+other], slice[idx+i]
+	}
+}
+
+func reverseRange[T any](slice []T, a, b int) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+type xorshift uint64
+
+func (this *xorshift) next() uint64 {
+	*this ^= *this << 13
+	*this ^= *this >> 7
+	*this ^= *this << 17
+	return uint64(*this)
+}
+
+func nextPowerOfTwo(length int) uint {
+	power := uint(1)
+	for power < uint(length) {
+		power <<= 1
+	}
+	return power
+}
+
+// log2(n)+1, the number of bad partitions allowed before pdqsort falls back to heap sort
+func sortLimit(n int) int {
+	limit := 0
+	for ; n > 0; n >>= 1 {
+		limit++
+	}
+	return limit
+}
+
+const stableBlockSize = 20 // size of the blocks sorted by insertion sort before merging them
+
+// Stable merge sort, uses a buffer of the size of the slice
+func StableSort[T any](slice []T, comp Ordf[T]) {
+	n := len(slice)
+	for a := 0; a < n; a += stableBlockSize {
+		insertionSort(slice, comp, a, Min(a+stableBlockSize, n))
+	}
+	if n <= stableBlockSize {
+		return
+	}
+
+	src, dst := slice, make([]T, n)
+	for width := stableBlockSize; width < n; width *= 2 {
+		for low := 0; low < n; low += 2 * width {
+			mid, high := Min(low+width, n), Min(low+2*width, n)
+			mergeInto(dst[low:high], src[low:mid], src[mid:high], comp)
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &slice[0] {
+		copy(slice, src)
+	}
+}
+
+func IsSorted[T any](slice []T, comp Ordf[T]) bool {
+	return IsSortedUntil(slice, comp) == len(slice)
+}
+
+// Returns the index of the first element smaller than its predecessor, len(slice) if sorted
+func IsSortedUntil[T any](slice []T, comp Ordf[T]) int {
+	for i := 1; i < len(slice); i++ {
+		if comp(slice[i], slice[i-1]) {
+			return i
+		}
+	}
+	return len(slice)
+}
@@ -0,0 +1,133 @@
+package algorithm
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func sortInputs(n int) map[string][]int {
+	r := rand.New(rand.NewSource(int64(n)))
+	inputs := map[string][]int{
+		"random":     make([]int, n),
+		"sorted":     make([]int, n),
+		"reversed":   make([]int, n),
+		"equal":      make([]int, n),
+		"few values": make([]int, n),
+		"organ pipe": make([]int, n),
+		"sawtooth":   make([]int, n),
+		"almost":     make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		inputs["random"][i] = r.Int()
+		inputs["sorted"][i] = i
+		inputs["reversed"][i] = n - i
+		inputs["equal"][i] = 42
+		inputs["few values"][i] = r.Intn(3)
+		inputs["organ pipe"][i] = Min(i, n-i)
+		inputs["sawtooth"][i] = i % 100
+		inputs["almost"][i] = i
+	}
+	for i := 0; i < n/100; i++ {
+		a, b := r.Intn(n), r.Intn(n)
+		inputs["almost"][a], inputs["almost"][b] = inputs["almost"][b], inputs["almost"][a]
+	}
+	return inputs
+}
+
+func TestSortPatterns(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 11, 12, 13, 49, 50, 51, 1000, 100000} {
+		for name, slice := range sortInputs(n) {
+			expected := append([]int{}, slice...)
+			sort.Ints(expected)
+
+			Sort(slice, Less[int])
+			if !SliceEqual_(slice, expected) {
+				t.Fatalf("%s (%d): the slice is not sorted", name, n)
+			}
+		}
+	}
+}
+
+func TestSortGreater(t *testing.T) {
+	slice := randomInts(1000, 5)
+	Sort(slice, Greater[int])
+	for i := 1; i < len(slice); i++ {
+		if slice[i-1] < slice[i] {
+			t.Fatalf("%d: %d should be before %d", i, slice[i], slice[i-1])
+		}
+	}
+}
+
+func TestSortStruct(t *testing.T) {
+	type record struct {
+		name string
+		age  int
+	}
+	r := rand.New(rand.NewSource(6))
+	slice := make([]record, 1000)
+	for i := range slice {
+		slice[i] = record{name: string(rune('a' + r.Intn(26))), age: r.Intn(10)}
+	}
+
+	Sort(slice, func(a, b record) bool {
+		if a.age != b.age {
+			return a.age < b.age
+		}
+		return a.name < b.name
+	})
+	for i := 1; i < len(slice); i++ {
+		a, b := slice[i-1], slice[i]
+		if a.age > b.age || (a.age == b.age && a.name > b.name) {
+			t.Fatalf("%d: %v should be before %v", i, b, a)
+		}
+	}
+}
+
+// Would be quadratic (or overflow the stack) with a naive quicksort
+func TestSortLargeSortedInput(t *testing.T) {
+	n := 1 << 21
+	slice := make([]int, n)
+	for i := range slice {
+		slice[i] = i
+	}
+	Sort(slice, Less[int])
+	for i := range slice {
+		if slice[i] != i {
+			t.Fatalf("%d: expected %d got %d", i, i, slice[i])
+		}
+	}
+
+	for i := range slice {
+		slice[i] = 7
+	}
+	Sort(slice, Less[int])
+}
+
+func TestHeapSortFallback(t *testing.T) {
+	slice := randomInts(1000, 7)
+	expected := append([]int{}, slice...)
+	sort.Ints(expected)
+
+	// a limit of 0 forces the heap sort
+	pdqsort(slice, Less[int], 0, len(slice), 0)
+	if !SliceEqual_(slice, expected) {
+		t.Fatalf("heap sort: the slice is not sorted")
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	b.ReportAllocs()
+	slice := make([]int, 1<<16)
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		r := rand.New(rand.NewSource(int64(n)))
+		for i := range slice {
+			slice[i] = r.Int()
+		}
+		b.StartTimer()
+		Sort(slice, Less[int])
+	}
+}
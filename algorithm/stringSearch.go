@@ -0,0 +1,100 @@
+package algorithm
+
+// Substring searches, same results as Search with better complexities
+
+// Knuth-Morris-Pratt, O(len(slice) + len(pattern))
+// Index of the first occurrence of pattern in slice, -1 if not found
+func SearchKMP[T comparable](slice, pattern []T) int {
+	if len(pattern) == 0 {
+		return 0
+	}
+	index := -1
+	kmp(slice, pattern, func(i int) bool {
+		index = i
+		return false
+	})
+	return index
+}
+
+// Start of every occurrence of pattern in slice, overlapping ones included (KMP)
+// An empty pattern matches at every position, from 0 to len(slice)
+func FindAll[T comparable](slice, pattern []T) []int {
+	if len(pattern) == 0 {
+		matches := make([]int, len(slice)+1)
+		Iota(matches, 0)
+		return matches
+	}
+	matches := []int{}
+	kmp(slice, pattern, func(i int) bool {
+		matches = append(matches, i)
+		return true
+	})
+	return matches
+}
+
+// Call found with the start of the matches until it returns false, pattern must not be empty
+func kmp[T comparable](slice, pattern []T, found func(int) bool) {
+	failure := kmpFailure(pattern)
+	matched := 0
+	for i, a := range slice {
+		for matched > 0 && a != pattern[matched] {
+			matched = failure[matched-1]
+		}
+		if a == pattern[matched] {
+			matched++
+		}
+		if matched == len(pattern) {
+			if !found(i + 1 - matched) {
+				return
+			}
+			matched = failure[matched-1]
+		}
+	}
+}
+
+// failure[i] is the length of the longest proper prefix of pattern[:i+1] that is also a suffix of it
+func kmpFailure[T comparable](pattern []T) []int {
+	failure := make([]int, len(pattern))
+	for i, length := 1, 0; i < len(pattern); i++ {
+		for length > 0 && pattern[i] != pattern[length] {
+			length = failure[length-1]
+		}
+		if pattern[i] == pattern[length] {
+			length++
+		}
+		failure[i] = length
+	}
+	return failure
+}
+
+// Boyer-Moore-Horspool, sublinear on average on large alphabets, O(len(slice) * len(pattern)) in the worst case
+// Index of the first occurrence of pattern in slice, -1 if not found
+func SearchHorspool[T comparable](slice, pattern []T) int {
+	m := len(pattern)
+	if m == 0 {
+		return 0
+	}
+
+	// distance between the last occurrence of a value in pattern[:m-1] and the end of the pattern
+	// the values missing from the table shift the whole pattern
+	shifts := make(map[T]int, m)
+	for i, a := range pattern[:m-1] {
+		shifts[a] = m - 1 - i
+	}
+
+	for i := 0; i+m <= len(slice); {
+		j := m - 1
+		for j >= 0 && slice[i+j] == pattern[j] {
+			j--
+		}
+		if j < 0 {
+			return i
+		}
+		if shift, ok := shifts[slice[i+m-1]]; ok {
+			i += shift
+		} else {
+			i += m
+		}
+	}
+	return -1
+}
@@ -0,0 +1,59 @@
+package algorithm
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func naiveFindAll(slice, pattern []byte) []int {
+	matches := []int{}
+	for i := 0; i+len(pattern) <= len(slice); i++ {
+		if SliceEqual_(slice[i:i+len(pattern)], pattern) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func randomBytes(r *rand.Rand, n int, alphabet string) []byte {
+	s := make([]byte, n)
+	for i := range s {
+		s[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return s
+}
+
+func TestStringSearchRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 5000; round++ {
+		alphabet := "abcdefgh"[:1+r.Intn(8)]
+		text := randomBytes(r, r.Intn(60), alphabet)
+		pattern := randomBytes(r, r.Intn(5), alphabet)
+
+		expected := Search(text, pattern)
+		if index := SearchKMP(text, pattern); index != expected {
+			t.Fatalf("SearchKMP(%q, %q): expected %d got %d", text, pattern, expected, index)
+		}
+		if index := SearchHorspool(text, pattern); index != expected {
+			t.Fatalf("SearchHorspool(%q, %q): expected %d got %d", text, pattern, expected, index)
+		}
+		if all := FindAll(text, pattern); !SliceEqual_(all, naiveFindAll(text, pattern)) {
+			t.Fatalf("FindAll(%q, %q): expected %v got %v", text, pattern, naiveFindAll(text, pattern), all)
+		}
+	}
+}
+
+func TestStringSearch(t *testing.T) {
+	if all := FindAll([]int{1, 1, 1, 1}, []int{1, 1}); !SliceEqual_(all, []int{0, 1, 2}) {
+		t.Fatalf("FindAll: expected overlapping matches got %v", all)
+	}
+	if all := FindAll([]int{5, 6}, nil); !SliceEqual_(all, []int{0, 1, 2}) {
+		t.Fatalf("FindAll: expected %v got %v", []int{0, 1, 2}, all)
+	}
+	if index := SearchHorspool([]string{"a", "b", "c"}, []string{"b", "c", "d"}); index != -1 {
+		t.Fatalf("SearchHorspool: expected %d got %d", -1, index)
+	}
+	if index := SearchKMP([]int{}, []int{}); index != 0 {
+		t.Fatalf("SearchKMP: expected %d got %d", 0, index)
+	}
+}
@@ -25,3 +25,10 @@ func Less[T Ord](a, b T) bool         { return a < b }
 func LessEqual[T Ord](a, b T) bool    { return a <= b }
 func Greater[T Ord](a, b T) bool      { return a > b }
 func GreaterEqual[T Ord](a, b T) bool { return a >= b }
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+func MakePair[A, B any](first A, second B) Pair[A, B] { return Pair[A, B]{first, second} }
@@ -0,0 +1,171 @@
+package diff
+
+import (
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+)
+
+func (this Op) String() string {
+	switch this {
+	case Equal:
+		return "equal"
+	case Delete:
+		return "delete"
+	case Insert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// Hunk of an edit script: a[AStart:AEnd] is deleted, b[BStart:BEnd] is inserted,
+// or both ranges are equal. The empty range of an insertion or a deletion is the position in the other slice
+type Edit struct {
+	Op           Op
+	AStart, AEnd int
+	BStart, BEnd int
+}
+
+// Shortest edit script turning a into b (Myers' algorithm in linear space, O((N+M)D) time)
+// Consecutive operations of the same kind are merged in a single hunk
+func Diff[T any](a, b []T, eq builtin.Compf[T]) []Edit {
+	script := &script{}
+	myers(a, b, eq, 0, 0, script)
+	return script.edits
+}
+
+// Longest common subsequence, the elements are taken from a
+func LCS[T any](a, b []T, eq builtin.Compf[T]) []T {
+	lcs := []T{}
+	for _, edit := range Diff(a, b, eq) {
+		if edit.Op == Equal {
+			lcs = append(lcs, a[edit.AStart:edit.AEnd]...)
+		}
+	}
+	return lcs
+}
+
+type script struct {
+	edits []Edit
+}
+
+// aPos and bPos are the positions of the current sub-problem in the whole slices
+func (this *script) add(op Op, aPos, bPos, n int) {
+	if n == 0 {
+		return
+	}
+	aLen, bLen := n, n
+	if op == Insert {
+		aLen = 0
+	} else if op == Delete {
+		bLen = 0
+	}
+
+	if last := len(this.edits) - 1; last >= 0 && this.edits[last].Op == op {
+		this.edits[last].AEnd += aLen
+		this.edits[last].BEnd += bLen
+		return
+	}
+	this.edits = append(this.edits, Edit{Op: op, AStart: aPos, AEnd: aPos + aLen, BStart: bPos, BEnd: bPos + bLen})
+}
+
+func myers[T any](a, b []T, eq builtin.Compf[T], aPos, bPos int, script *script) {
+	prefix := commonPrefix(a, b, eq)
+	script.add(Equal, aPos, bPos, prefix)
+	a, b, aPos, bPos = a[prefix:], b[prefix:], aPos+prefix, bPos+prefix
+
+	suffix := commonSuffix(a, b, eq)
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		script.add(Insert, aPos, bPos, len(b))
+	case len(b) == 0:
+		script.add(Delete, aPos, bPos, len(a))
+	default:
+		x0, y0, x1, y1 := middleSnake(a, b, eq)
+		myers(a[:x0], b[:y0], eq, aPos, bPos, script)
+		script.add(Equal, aPos+x0, bPos+y0, x1-x0)
+		myers(a[x1:], b[y1:], eq, aPos+x1, bPos+y1, script)
+	}
+	script.add(Equal, aPos+len(a), bPos+len(b), suffix)
+}
+
+func commonPrefix[T any](a, b []T, eq builtin.Compf[T]) int {
+	n := algorithm.Min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if !eq(a[i], b[i]) {
+			return i
+		}
+	}
+	return n
+}
+
+func commonSuffix[T any](a, b []T, eq builtin.Compf[T]) int {
+	n := algorithm.Min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if !eq(a[len(a)-1-i], b[len(b)-1-i]) {
+			return i
+		}
+	}
+	return n
+}
+
+// Find the snake (x0, y0) -> (x1, y1) in the middle of a shortest edit path by searching
+// from both ends at the same time. a and b must be non empty and differ on their first and last elements,
+// so that both halves of the path are shorter than the whole one
+func middleSnake[T any](a, b []T, eq builtin.Compf[T]) (x0, y0, x1, y1 int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	max := (n + m + 1) / 2
+	offset := max + 1
+	// furthest x reached on each diagonal k = x - y, the backward one is in reversed coordinates
+	forward := make([]int, 2*max+3)
+	backward := make([]int, 2*max+3)
+
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			x := forward[offset+k-1] + 1
+			if k == -d || k != d && forward[offset+k-1] < forward[offset+k+1] {
+				x = forward[offset+k+1]
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && eq(a[x], b[y]) {
+				x, y = x+1, y+1
+			}
+			forward[offset+k] = x
+
+			// the backward search has done d-1 steps
+			if kr := delta - k; delta%2 != 0 && kr >= -(d-1) && kr <= d-1 && x+backward[offset+kr] >= n {
+				return startX, startY, x, y
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			x := backward[offset+k-1] + 1
+			if k == -d || k != d && backward[offset+k-1] < backward[offset+k+1] {
+				x = backward[offset+k+1]
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && eq(a[n-1-x], b[m-1-y]) {
+				x, y = x+1, y+1
+			}
+			backward[offset+k] = x
+
+			if kf := delta - k; delta%2 == 0 && kf >= -d && kf <= d && x+forward[offset+kf] >= n {
+				return n - x, m - y, n - startX, m - startY
+			}
+		}
+	}
+	panic("diff: no middle snake found")
+}
@@ -0,0 +1,129 @@
+package diff
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Length of the longest common subsequence with the quadratic dynamic programming
+func lcsLength(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1] + 1
+			} else {
+				table[i][j] = algorithm.Max(table[i-1][j], table[i][j-1])
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+// Check that the edits cover both slices contiguously, rebuild b and return the number of edited elements
+func applyScript(t *testing.T, a, b []int, edits []Edit) int {
+	aPos, bPos, edited := 0, 0, 0
+	rebuilt := []int{}
+	for i, edit := range edits {
+		if edit.AStart != aPos || edit.BStart != bPos {
+			t.Fatalf("edit %d %+v does not start at (%d, %d)", i, edit, aPos, bPos)
+		}
+		if i > 0 && edits[i-1].Op == edit.Op {
+			t.Fatalf("edits %d and %d should have been merged", i-1, i)
+		}
+		switch edit.Op {
+		case Equal:
+			if !algorithm.SliceEqual_(a[edit.AStart:edit.AEnd], b[edit.BStart:edit.BEnd]) {
+				t.Fatalf("edit %d %+v: the ranges are not equal", i, edit)
+			}
+			rebuilt = append(rebuilt, a[edit.AStart:edit.AEnd]...)
+		case Delete:
+			if edit.BStart != edit.BEnd {
+				t.Fatalf("edit %d %+v: a deletion must have an empty b range", i, edit)
+			}
+			edited += edit.AEnd - edit.AStart
+		case Insert:
+			if edit.AStart != edit.AEnd {
+				t.Fatalf("edit %d %+v: an insertion must have an empty a range", i, edit)
+			}
+			rebuilt = append(rebuilt, b[edit.BStart:edit.BEnd]...)
+			edited += edit.BEnd - edit.BStart
+		}
+		aPos, bPos = edit.AEnd, edit.BEnd
+	}
+	if aPos != len(a) || bPos != len(b) {
+		t.Fatalf("the edits end at (%d, %d) instead of (%d, %d)", aPos, bPos, len(a), len(b))
+	}
+	if !algorithm.SliceEqual_(rebuilt, b) {
+		t.Fatalf("the script does not rebuild b: %v %v", rebuilt, b)
+	}
+	return edited
+}
+
+func randomSequence(r *rand.Rand, n, alphabet int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(alphabet)
+	}
+	return s
+}
+
+func TestDiffRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 2000; round++ {
+		a := randomSequence(r, r.Intn(30), 1+r.Intn(4))
+		b := randomSequence(r, r.Intn(30), 1+r.Intn(4))
+
+		edits := Diff(a, b, builtin.Equal[int])
+		edited := applyScript(t, a, b, edits)
+		lcs := lcsLength(a, b)
+		if expected := len(a) + len(b) - 2*lcs; edited != expected {
+			t.Fatalf("%v %v: expected %d edited elements got %d", a, b, expected, edited)
+		}
+		if common := LCS(a, b, builtin.Equal[int]); len(common) != lcs {
+			t.Fatalf("%v %v: expected a LCS of length %d got %v", a, b, lcs, common)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := []string{"a", "b", "c", "e", "f"}
+	b := []string{"a", "c", "d", "e", "f", "g"}
+	expected := []Edit{
+		{Op: Equal, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+		{Op: Delete, AStart: 1, AEnd: 2, BStart: 1, BEnd: 1},
+		{Op: Equal, AStart: 2, AEnd: 3, BStart: 1, BEnd: 2},
+		{Op: Insert, AStart: 3, AEnd: 3, BStart: 2, BEnd: 3},
+		{Op: Equal, AStart: 3, AEnd: 5, BStart: 3, BEnd: 5},
+		{Op: Insert, AStart: 5, AEnd: 5, BStart: 5, BEnd: 6},
+	}
+	if edits := Diff(a, b, builtin.Equal[string]); !algorithm.SliceEqual_(edits, expected) {
+		t.Fatalf("expected %v got %v", expected, edits)
+	}
+
+	if edits := Diff([]int{}, []int{}, builtin.Equal[int]); len(edits) != 0 {
+		t.Fatalf("expected no edit got %v", edits)
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	// completely different inputs: the linear space version must not allocate O(N*D)
+	r := rand.New(rand.NewSource(2))
+	a := randomSequence(r, 5000, 1000)
+	b := make([]int, 5000)
+	for i := range b {
+		b[i] = a[i] + 1000
+	}
+	b[2500] = a[2500]
+
+	edited := applyScript(t, a, b, Diff(a, b, builtin.Equal[int]))
+	if edited != 2*4999 {
+		t.Fatalf("expected %d edited elements got %d", 2*4999, edited)
+	}
+}
@@ -0,0 +1,37 @@
+package diff
+
+import (
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+type Costs struct {
+	Insert     int
+	Delete     int
+	Substitute int
+}
+
+var UnitCosts = Costs{Insert: 1, Delete: 1, Substitute: 1}
+
+// Minimal cost to turn a into b with insertions, deletions and substitutions
+// O(len(a)*len(b)) time, O(len(b)) memory
+func Levenshtein[T any](a, b []T, eq builtin.Compf[T], costs Costs) int {
+	// previous[j] is the distance between a[:i-1] and b[:j]
+	previous, current := make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j * costs.Insert
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i * costs.Delete
+		for j := 1; j <= len(b); j++ {
+			substitute := previous[j-1]
+			if !eq(a[i-1], b[j-1]) {
+				substitute += costs.Substitute
+			}
+			current[j] = algorithm.Min_(substitute, previous[j]+costs.Delete, current[j-1]+costs.Insert)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
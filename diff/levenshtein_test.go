@@ -0,0 +1,32 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		costs    Costs
+		expected int
+	}{
+		{"kitten", "sitting", UnitCosts, 3},
+		{"", "abc", UnitCosts, 3},
+		{"abc", "", UnitCosts, 3},
+		{"abc", "abc", UnitCosts, 0},
+		{"flaw", "lawn", UnitCosts, 2},
+		// a substitution is more expensive than a deletion and an insertion
+		{"abc", "axc", Costs{Insert: 1, Delete: 1, Substitute: 3}, 2},
+		{"abc", "ab", Costs{Insert: 1, Delete: 5, Substitute: 1}, 5},
+		{"", "ab", Costs{Insert: 4, Delete: 1, Substitute: 1}, 8},
+	}
+
+	for _, test := range tests {
+		distance := Levenshtein([]byte(test.a), []byte(test.b), builtin.Equal[byte], test.costs)
+		if distance != test.expected {
+			t.Fatalf("Levenshtein(%q, %q, %+v): expected %d got %d", test.a, test.b, test.costs, test.expected, distance)
+		}
+	}
+}
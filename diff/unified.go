@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Render the diff of two slices of lines in the unified format, with context lines around each change
+// The lines must not contain their trailing '\n'. Returns "" when a and b are equal
+func Unified(aName, bName string, a, b []string, context int) string {
+	if context < 0 {
+		context = 0
+	}
+
+	// one entry per line of the output
+	type line struct {
+		op         Op
+		aPos, bPos int
+	}
+	lines := []line{}
+	for _, edit := range Diff(a, b, builtin.Equal[string]) {
+		for i, j := edit.AStart, edit.BStart; i < edit.AEnd || j < edit.BEnd; {
+			lines = append(lines, line{op: edit.Op, aPos: i, bPos: j})
+			if edit.Op != Insert {
+				i++
+			}
+			if edit.Op != Delete {
+				j++
+			}
+		}
+	}
+
+	out := strings.Builder{}
+	for start := 0; start < len(lines); {
+		// first change not yet rendered
+		for start < len(lines) && lines[start].op == Equal {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+
+		// extend the hunk while the gap between two changes can be covered by their contexts
+		end := start
+		for i := start; i < len(lines) && i-end <= 2*context; i++ {
+			if lines[i].op != Equal {
+				end = i + 1
+			}
+		}
+		low, high := algorithm.Max(start-context, 0), algorithm.Min(end+context, len(lines))
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+		}
+		aCount, bCount := 0, 0
+		for _, l := range lines[low:high] {
+			if l.op != Insert {
+				aCount++
+			}
+			if l.op != Delete {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(lines[low].aPos, aCount), hunkRange(lines[low].bPos, bCount))
+
+		for _, l := range lines[low:high] {
+			switch l.op {
+			case Equal:
+				out.WriteString(" " + a[l.aPos] + "\n")
+			case Delete:
+				out.WriteString("-" + a[l.aPos] + "\n")
+			case Insert:
+				out.WriteString("+" + b[l.bPos] + "\n")
+			}
+		}
+		start = high
+	}
+	return out.String()
+}
+
+// 1-based start line, an empty range starts at the line before it
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
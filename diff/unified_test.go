@@ -0,0 +1,52 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	a := strings.Split("1 2 3 4 5 6 7 8 9 10 11 12 13", " ")
+	b := strings.Split("1 2 x 4 5 6 7 8 9 10 11 13 14", " ")
+
+	expected := `--- a.txt
++++ b.txt
+@@ -1,5 +1,5 @@
+ 1
+ 2
+-3
++x
+ 4
+ 5
+@@ -10,4 +10,4 @@
+ 10
+ 11
+-12
+ 13
++14
+`
+	if diff := Unified("a.txt", "b.txt", a, b, 2); diff != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, diff)
+	}
+
+	// the contexts of the two changes overlap: a single hunk
+	if diff := Unified("a", "b", a, b, 4); strings.Count(diff, "@@ -") != 1 {
+		t.Fatalf("expected a single hunk got\n%s", diff)
+	}
+}
+
+func TestUnifiedEdgeCases(t *testing.T) {
+	if diff := Unified("a", "b", []string{"x"}, []string{"x"}, 3); diff != "" {
+		t.Fatalf("expected no diff got\n%s", diff)
+	}
+
+	expected := "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if diff := Unified("a", "b", nil, []string{"x", "y"}, 3); diff != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, diff)
+	}
+
+	expected = "--- a\n+++ b\n@@ -2 +1,0 @@\n-y\n"
+	if diff := Unified("a", "b", []string{"x", "y", "z"}, []string{"x", "z"}, 0); diff != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, diff)
+	}
+}
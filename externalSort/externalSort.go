@@ -0,0 +1,310 @@
+package externalsort
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"strings"
+
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+	"github.com/AlexandreChamard/go-generic/iterator"
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+)
+
+// Used to read the input and to write and read back the sorted runs
+type Codec[T any] interface {
+	Encode(w io.Writer, value T) error
+	// Must return io.EOF, and only io.EOF, when there is no record left
+	Decode(r *bufio.Reader) (T, error)
+}
+
+type Config struct {
+	// Approximate size of the records kept in memory, measured on their encoded size (64MiB on <=0)
+	MemoryBudget int64
+	// Directory of the temporary run files (os.TempDir() on "")
+	TempDir string
+	// Maximum number of run files open at once (64 on <=0, at least 2)
+	// When there are more runs, they are merged by groups into bigger runs until the limit is met
+	MaxOpenRuns int
+}
+
+const (
+	defaultMemoryBudget = 64 << 20
+	defaultMaxOpenRuns  = 64
+)
+
+type Sorted[T any] interface {
+	// Yields the records in order, can only be iterated once
+	// The iteration stops on the first error, returned by Err
+	Values() iter.Seq[T]
+	Err() error
+	// Remove the temporary files, must be called even if Values has not been iterated
+	Close() error
+}
+
+// Stable sort of the records of input
+// The input is read and split in sorted runs before returning, the last merge is done lazily by Values
+// The run files are only open while they are written or merged
+func Sort[T any](input io.Reader, codec Codec[T], comp builtin.Ordf[T], config Config) (Sorted[T], error) {
+	budget := config.MemoryBudget
+	if budget <= 0 {
+		budget = defaultMemoryBudget
+	}
+	maxOpenRuns := config.MaxOpenRuns
+	if maxOpenRuns <= 0 {
+		maxOpenRuns = defaultMaxOpenRuns
+	}
+	maxOpenRuns = algorithm.Max(maxOpenRuns, 2)
+
+	counter := &countingReader{r: input}
+	reader := bufio.NewReader(counter)
+	result := &sorted[T]{codec: codec, comp: comp, dir: config.TempDir}
+
+	for {
+		records, eof, err := readRun(reader, counter, codec, budget)
+		if err != nil {
+			result.Close()
+			return nil, err
+		}
+		algorithm.StableSort(records, comp)
+
+		if len(result.runs) == 0 && eof {
+			// everything fits in memory
+			result.memory = records
+			return result, nil
+		}
+		if len(records) > 0 {
+			err := result.newRun(func(write func(T) error) error {
+				for _, record := range records {
+					if err := write(record); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				result.Close()
+				return nil, err
+			}
+		}
+		if eof {
+			if err := result.reduceRuns(maxOpenRuns); err != nil {
+				result.Close()
+				return nil, err
+			}
+			return result, nil
+		}
+	}
+}
+
+// Decode records until the budget is reached or the input is empty
+func readRun[T any](reader *bufio.Reader, counter *countingReader, codec Codec[T], budget int64) ([]T, bool, error) {
+	// bytes decoded so far, the ones still in the buffer have not been consumed yet
+	consumed := func() int64 { return counter.n - int64(reader.Buffered()) }
+	start := consumed()
+
+	records := []T{}
+	for consumed()-start < budget {
+		record, err := codec.Decode(reader)
+		if err == io.EOF {
+			return records, true, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		records = append(records, record)
+	}
+	return records, false, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (this *countingReader) Read(p []byte) (int, error) {
+	n, err := this.r.Read(p)
+	this.n += int64(n)
+	return n, err
+}
+
+type sorted[T any] struct {
+	codec  Codec[T]
+	comp   builtin.Ordf[T]
+	dir    string
+	memory []T
+	runs   []string // paths of the run files, in the order of the input
+	err    error
+	used   bool
+}
+
+// Create a run file filled by fill, the file is closed before returning
+// It is added to the runs even on error so that Close removes it
+func (this *sorted[T]) newRun(fill func(write func(T) error) error) error {
+	file, err := os.CreateTemp(this.dir, "externalsort-*")
+	if err != nil {
+		return err
+	}
+	this.runs = append(this.runs, file.Name())
+
+	writer := bufio.NewWriter(file)
+	err = fill(func(value T) error { return this.codec.Encode(writer, value) })
+	if err == nil {
+		err = writer.Flush()
+	}
+	return errors.Join(err, file.Close())
+}
+
+// Merge groups of consecutive runs until there are at most maxOpenRuns of them
+// Only consecutive runs are merged together to keep the sort stable
+func (this *sorted[T]) reduceRuns(maxOpenRuns int) error {
+	for len(this.runs) > maxOpenRuns {
+		inputs := this.runs
+		this.runs = nil
+		for low := 0; low < len(inputs); low += maxOpenRuns {
+			group := inputs[low:algorithm.Min(low+maxOpenRuns, len(inputs))]
+			if len(group) == 1 {
+				this.runs = append(this.runs, group[0])
+				continue
+			}
+
+			err := this.newRun(func(write func(T) error) error {
+				var writeErr error
+				err := this.merge(group, func(value T) bool {
+					writeErr = write(value)
+					return writeErr == nil
+				})
+				return errors.Join(err, writeErr)
+			})
+			if err != nil {
+				// the inputs not merged yet are still owned by Close
+				this.runs = append(this.runs, inputs[low:]...)
+				return err
+			}
+			for i, path := range group {
+				if err := os.Remove(path); err != nil {
+					this.runs = append(this.runs, group[i:]...)
+					this.runs = append(this.runs, inputs[low+len(group):]...)
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type mergeItem[T any] struct {
+	value T
+	run   int
+}
+
+// k-way merge of the run files, stops without error when yield returns false
+func (this *sorted[T]) merge(paths []string, yield func(T) bool) error {
+	readers := make([]*bufio.Reader, len(paths))
+	for i, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		readers[i] = bufio.NewReader(file)
+	}
+
+	// the run index breaks the ties to keep the sort stable
+	queue := priorityqueue.NewPriorityQueue(func(a, b mergeItem[T]) bool {
+		if this.comp(a.value, b.value) {
+			return true
+		}
+		return !this.comp(b.value, a.value) && a.run < b.run
+	})
+	next := func(i int) error {
+		value, err := this.codec.Decode(readers[i])
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		queue.Push(mergeItem[T]{value: value, run: i})
+		return nil
+	}
+
+	for i := range readers {
+		if err := next(i); err != nil {
+			return err
+		}
+	}
+	for !queue.Empty() {
+		front := queue.Front()
+		queue.Pop()
+		if !yield(front.value) {
+			return nil
+		}
+		if err := next(front.run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *sorted[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if this.used {
+			this.err = errors.New("externalsort: Values can only be iterated once")
+			return
+		}
+		this.used = true
+
+		if len(this.runs) == 0 {
+			iterator.FromSlice(this.memory)(yield)
+			return
+		}
+		this.err = this.merge(this.runs, yield)
+	}
+}
+
+func (this *sorted[T]) Err() error { return this.err }
+
+func (this *sorted[T]) Close() error {
+	errs := []error{}
+	for _, path := range this.runs {
+		errs = append(errs, os.Remove(path))
+	}
+	this.runs = nil
+	this.memory = nil
+	return errors.Join(errs...)
+}
+
+// One string per line, the strings must not contain '\n'
+type LinesCodec struct{}
+
+func (LinesCodec) Encode(w io.Writer, value string) error {
+	_, err := io.WriteString(w, value+"\n")
+	return err
+}
+
+func (LinesCodec) Decode(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		// last line without a trailing '\n'
+		err = nil
+	}
+	return strings.TrimSuffix(line, "\n"), err
+}
+
+// Fixed size values (see encoding/binary) in big endian
+type BinaryCodec[T any] struct{}
+
+func (BinaryCodec[T]) Encode(w io.Writer, value T) error {
+	return binary.Write(w, binary.BigEndian, value)
+}
+
+func (BinaryCodec[T]) Decode(r *bufio.Reader) (T, error) {
+	var value T
+	err := binary.Read(r, binary.BigEndian, &value)
+	return value, err
+}
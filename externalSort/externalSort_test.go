@@ -0,0 +1,250 @@
+package externalsort
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+	"github.com/AlexandreChamard/go-generic/iterator"
+)
+
+func encodeAll[T any](t *testing.T, codec Codec[T], values []T) *bytes.Buffer {
+	buffer := &bytes.Buffer{}
+	for _, v := range values {
+		if err := codec.Encode(buffer, v); err != nil {
+			t.Fatalf("Encode: unexpected error %v", err)
+		}
+	}
+	return buffer
+}
+
+func checkTempDirEmpty(t *testing.T, dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: unexpected error %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected the temporary files to be removed, %d left", len(entries))
+	}
+}
+
+func TestSortInMemory(t *testing.T) {
+	input := strings.NewReader("pear\napple\nfig\nbanana")
+	result, err := Sort[string](input, LinesCodec{}, builtin.Less[string], Config{})
+	if err != nil {
+		t.Fatalf("Sort: unexpected error %v", err)
+	}
+	defer result.Close()
+
+	if runs := len(result.(*sorted[string]).runs); runs != 0 {
+		t.Fatalf("expected no run file got %d", runs)
+	}
+	output := iterator.Collect(result.Values())
+	expected := []string{"apple", "banana", "fig", "pear"}
+	if strings.Join(output, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected %v got %v", expected, output)
+	}
+	if result.Err() != nil {
+		t.Fatalf("Err: unexpected error %v", result.Err())
+	}
+}
+
+func TestSortSpill(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int64, 10000)
+	for i := range values {
+		values[i] = r.Int63n(2000) - 1000
+	}
+	dir := t.TempDir()
+
+	// 500 records per run
+	result, err := Sort[int64](encodeAll[int64](t, BinaryCodec[int64]{}, values), BinaryCodec[int64]{}, builtin.Less[int64],
+		Config{MemoryBudget: 4000, TempDir: dir})
+	if err != nil {
+		t.Fatalf("Sort: unexpected error %v", err)
+	}
+	if runs := len(result.(*sorted[int64]).runs); runs != 20 {
+		t.Fatalf("expected %d runs got %d", 20, runs)
+	}
+
+	output := iterator.Collect(result.Values())
+	if result.Err() != nil {
+		t.Fatalf("Err: unexpected error %v", result.Err())
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	if len(output) != len(values) {
+		t.Fatalf("expected %d values got %d", len(values), len(output))
+	}
+	for i := range values {
+		if output[i] != values[i] {
+			t.Fatalf("%d: expected %d got %d", i, values[i], output[i])
+		}
+	}
+
+	iterator.Collect(result.Values())
+	if result.Err() == nil {
+		t.Fatalf("Err: expected an error on the second iteration")
+	}
+
+	if err := result.Close(); err != nil {
+		t.Fatalf("Close: unexpected error %v", err)
+	}
+	checkTempDirEmpty(t, dir)
+}
+
+type record struct {
+	Key   int32
+	Index int32
+}
+
+// Number of file descriptors of the process, -1 if /proc is not available
+func openFiles() int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return -1
+	}
+	return len(entries)
+}
+
+func TestSortMultiPassMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	records := make([]record, 20000)
+	for i := range records {
+		records[i] = record{Key: r.Int31n(50), Index: int32(i)}
+	}
+	dir := t.TempDir()
+
+	// the runtime opens a few descriptors on the first file opened, do it before counting
+	if file, err := os.CreateTemp(dir, "warmup-*"); err == nil {
+		file.Close()
+		os.Remove(file.Name())
+	}
+	before := openFiles()
+	// 250 runs of 80 records, merged 4 by 4 in several passes
+	result, err := Sort[record](encodeAll[record](t, BinaryCodec[record]{}, records), BinaryCodec[record]{},
+		func(a, b record) bool { return a.Key < b.Key }, Config{MemoryBudget: 640, TempDir: dir, MaxOpenRuns: 4})
+	if err != nil {
+		t.Fatalf("Sort: unexpected error %v", err)
+	}
+	if runs := len(result.(*sorted[record]).runs); runs > 4 {
+		t.Fatalf("expected at most %d runs left for Values got %d", 4, runs)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) > 4 {
+		t.Fatalf("expected the merged runs to be removed, %d files left", len(entries))
+	}
+	if after := openFiles(); before >= 0 && after > before {
+		t.Fatalf("the run files should be closed after Sort: %d open files before, %d after", before, after)
+	}
+
+	count := 0
+	var previous *record
+	for rec := range result.Values() {
+		if previous != nil && (previous.Key > rec.Key || previous.Key == rec.Key && previous.Index > rec.Index) {
+			t.Fatalf("%v is yielded after %v", rec, *previous)
+		}
+		previous = &rec
+		count++
+	}
+	if result.Err() != nil {
+		t.Fatalf("Err: unexpected error %v", result.Err())
+	}
+	if count != len(records) {
+		t.Fatalf("expected %d records got %d", len(records), count)
+	}
+	if after := openFiles(); before >= 0 && after > before {
+		t.Fatalf("the run files should be closed after the merge: %d open files before, %d after", before, after)
+	}
+
+	if err := result.Close(); err != nil {
+		t.Fatalf("Close: unexpected error %v", err)
+	}
+	checkTempDirEmpty(t, dir)
+}
+
+func TestSortStable(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	records := make([]record, 5000)
+	for i := range records {
+		records[i] = record{Key: r.Int31n(10), Index: int32(i)}
+	}
+	dir := t.TempDir()
+
+	result, err := Sort[record](encodeAll[record](t, BinaryCodec[record]{}, records), BinaryCodec[record]{},
+		func(a, b record) bool { return a.Key < b.Key }, Config{MemoryBudget: 1000, TempDir: dir})
+	if err != nil {
+		t.Fatalf("Sort: unexpected error %v", err)
+	}
+	defer result.Close()
+
+	var previous *record
+	count := 0
+	for rec := range result.Values() {
+		if previous != nil && (previous.Key > rec.Key || previous.Key == rec.Key && previous.Index > rec.Index) {
+			t.Fatalf("%v is yielded after %v", rec, *previous)
+		}
+		previous = &rec
+		count++
+	}
+	if result.Err() != nil {
+		t.Fatalf("Err: unexpected error %v", result.Err())
+	}
+	if count != len(records) {
+		t.Fatalf("expected %d records got %d", len(records), count)
+	}
+}
+
+func TestSortEarlyStop(t *testing.T) {
+	values := []int64{5, 3, 8, 1, 9, 2, 7}
+	dir := t.TempDir()
+	result, err := Sort[int64](encodeAll[int64](t, BinaryCodec[int64]{}, values), BinaryCodec[int64]{}, builtin.Less[int64],
+		Config{MemoryBudget: 16, TempDir: dir})
+	if err != nil {
+		t.Fatalf("Sort: unexpected error %v", err)
+	}
+
+	output := iterator.Collect(iterator.Take(result.Values(), 3))
+	if len(output) != 3 || output[0] != 1 || output[1] != 2 || output[2] != 3 {
+		t.Fatalf("expected [1 2 3] got %v", output)
+	}
+	if err := result.Close(); err != nil {
+		t.Fatalf("Close: unexpected error %v", err)
+	}
+	checkTempDirEmpty(t, dir)
+}
+
+func TestSortDecodeError(t *testing.T) {
+	dir := t.TempDir()
+	// the second record is truncated
+	input := bytes.NewReader(make([]byte, 12))
+	_, err := Sort[int64](input, BinaryCodec[int64]{}, builtin.Less[int64], Config{MemoryBudget: 8, TempDir: dir})
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("Sort: expected %v got %v", io.ErrUnexpectedEOF, err)
+	}
+	checkTempDirEmpty(t, dir)
+}
+
+func TestCodecs(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader("a\n\nb"))
+	for _, expected := range []string{"a", "", "b"} {
+		line, err := LinesCodec{}.Decode(reader)
+		if err != nil || line != expected {
+			t.Fatalf("Decode: expected %q got %q (%v)", expected, line, err)
+		}
+	}
+	if _, err := (LinesCodec{}).Decode(reader); err != io.EOF {
+		t.Fatalf("Decode: expected %v got %v", io.EOF, err)
+	}
+
+	buffer := &bytes.Buffer{}
+	binary.Write(buffer, binary.BigEndian, int64(1))
+	if v, err := (BinaryCodec[int64]{}).Decode(bufio.NewReader(buffer)); err != nil || v != 1 {
+		t.Fatalf("BinaryCodec: expected %d got %d (%v)", 1, v, err)
+	}
+}
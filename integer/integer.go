@@ -0,0 +1,172 @@
+package integer
+
+import (
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Arithmetic helpers on builtin.IntegerType
+// The ...Overflow functions return the wrapped result and true when the exact result does not fit in T
+
+func Signed[T builtin.IntegerType]() bool {
+	return ^T(0) < 0
+}
+
+func bitSize[T builtin.IntegerType]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+func MinValue[T builtin.IntegerType]() T {
+	if Signed[T]() {
+		return T(1) << (bitSize[T]() - 1)
+	}
+	return 0
+}
+
+func MaxValue[T builtin.IntegerType]() T {
+	if Signed[T]() {
+		return ^MinValue[T]()
+	}
+	return ^T(0)
+}
+
+// Abs(MinValue[T]()) overflows and returns MinValue[T]()
+func Abs[T builtin.IntegerType](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Panics if lo > hi
+func Clamp[T builtin.IntegerType](x, lo, hi T) T {
+	if lo > hi {
+		panic("integer.Clamp: lo > hi")
+	}
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+	return x
+}
+
+// Always positive or zero, GCD(0, 0) == 0
+// Overflows like Abs when the result is -MinValue[T]()
+func GCD[T builtin.IntegerType](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// Always positive or zero, LCM(a, 0) == 0
+// The boolean is true when the result overflows
+func LCM[T builtin.IntegerType](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+	lcm, overflow := MulOverflow(a/GCD(a, b), b)
+	if lcm < 0 {
+		if lcm == MinValue[T]() {
+			return lcm, true
+		}
+		lcm = -lcm
+	}
+	return lcm, overflow
+}
+
+func AddOverflow[T builtin.IntegerType](a, b T) (T, bool) {
+	sum := a + b
+	if Signed[T]() {
+		return sum, (sum > a) != (b > 0)
+	}
+	return sum, sum < a
+}
+
+func SubOverflow[T builtin.IntegerType](a, b T) (T, bool) {
+	diff := a - b
+	if Signed[T]() {
+		return diff, (diff < a) != (b > 0)
+	}
+	return diff, diff > a
+}
+
+func MulOverflow[T builtin.IntegerType](a, b T) (T, bool) {
+	product := a * b
+	if a == 0 || b == 0 {
+		return product, false
+	}
+	// MinValue / -1 wraps instead of panicking, the division check would miss it
+	if minusOne := ^T(0); Signed[T]() && (a == minusOne && b == MinValue[T]() || b == minusOne && a == MinValue[T]()) {
+		return product, true
+	}
+	return product, product/b != a
+}
+
+// Returns false if x can not be represented in To
+func SafeConvert[To, From builtin.IntegerType](x From) (To, bool) {
+	y := To(x)
+	return y, From(y) == x && (x < 0) == (y < 0)
+}
+
+// base^exp % mod, the result is in [0, mod)
+// Panics if mod <= 0 or exp < 0
+func PowMod[T builtin.IntegerType](base, exp, mod T) T {
+	if mod <= 0 {
+		panic("integer.PowMod: mod <= 0")
+	}
+	if exp < 0 {
+		panic("integer.PowMod: negative exponent")
+	}
+
+	m := uint64(mod)
+	b := uint64(base % mod)
+	if base%mod < 0 {
+		b = uint64(base%mod + mod)
+	}
+	result := uint64(1) % m
+	for e := uint64(exp); e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = mulMod(result, b, m)
+		}
+		b = mulMod(b, b, m)
+	}
+	return T(result)
+}
+
+// a*b % m on 128 bits, a and b must be lower than m
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// Floor of the square root, panics if x < 0
+func Sqrt[T builtin.IntegerType](x T) T {
+	if x < 0 {
+		panic("integer.Sqrt: negative value")
+	}
+	n := uint64(x)
+	// the float estimate can be off by one on big values
+	r := uint64(math.Sqrt(float64(n)))
+	for r > 0 && r > n/r {
+		r--
+	}
+	for r+1 <= n/(r+1) {
+		r++
+	}
+	return T(r)
+}
+
+// Floor of the base 2 logarithm, panics if x <= 0
+func Log2[T builtin.IntegerType](x T) int {
+	if x <= 0 {
+		panic("integer.Log2: value <= 0")
+	}
+	return bits.Len64(uint64(x)) - 1
+}
@@ -0,0 +1,192 @@
+package integer
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestLimits(t *testing.T) {
+	if MinValue[int8]() != math.MinInt8 || MaxValue[int8]() != math.MaxInt8 {
+		t.Fatalf("int8: got [%d, %d]", MinValue[int8](), MaxValue[int8]())
+	}
+	if MinValue[uint16]() != 0 || MaxValue[uint16]() != math.MaxUint16 {
+		t.Fatalf("uint16: got [%d, %d]", MinValue[uint16](), MaxValue[uint16]())
+	}
+	if MinValue[int64]() != math.MinInt64 || MaxValue[int64]() != math.MaxInt64 {
+		t.Fatalf("int64: got [%d, %d]", MinValue[int64](), MaxValue[int64]())
+	}
+	if MaxValue[uint64]() != math.MaxUint64 {
+		t.Fatalf("uint64: got %d", MaxValue[uint64]())
+	}
+	if !Signed[int]() || Signed[uintptr]() {
+		t.Fatalf("Signed: wrong signedness")
+	}
+}
+
+// a and b are small enough for the exact result to fit in an int
+func checkOverflow[T int8 | uint8](t *testing.T, name string, op func(T, T) (T, bool), exact func(a, b int) int) {
+	lo, hi := int(MinValue[T]()), int(MaxValue[T]())
+	for a := lo; a <= hi; a++ {
+		for b := lo; b <= hi; b++ {
+			result, overflow := op(T(a), T(b))
+			expected := exact(a, b)
+			if result != T(expected) {
+				t.Fatalf("%s(%d, %d): expected the wrapped result %d got %d", name, a, b, T(expected), result)
+			}
+			if overflow != (expected < lo || expected > hi) {
+				t.Fatalf("%s(%d, %d): wrong overflow %v", name, a, b, overflow)
+			}
+		}
+	}
+}
+
+func TestOverflowExhaustive(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	sub := func(a, b int) int { return a - b }
+	mul := func(a, b int) int { return a * b }
+
+	checkOverflow(t, "AddOverflow[int8]", AddOverflow[int8], add)
+	checkOverflow(t, "SubOverflow[int8]", SubOverflow[int8], sub)
+	checkOverflow(t, "MulOverflow[int8]", MulOverflow[int8], mul)
+	checkOverflow(t, "AddOverflow[uint8]", AddOverflow[uint8], add)
+	checkOverflow(t, "SubOverflow[uint8]", SubOverflow[uint8], sub)
+	checkOverflow(t, "MulOverflow[uint8]", MulOverflow[uint8], mul)
+}
+
+func TestOverflowWide(t *testing.T) {
+	if _, overflow := AddOverflow[int64](math.MaxInt64, 1); !overflow {
+		t.Fatalf("AddOverflow: expected an overflow")
+	}
+	if _, overflow := MulOverflow[int64](math.MinInt64, -1); !overflow {
+		t.Fatalf("MulOverflow: expected an overflow")
+	}
+	if r, overflow := MulOverflow[uint64](1<<32, 1<<31); overflow || r != 1<<63 {
+		t.Fatalf("MulOverflow: expected %d got %d (%v)", uint64(1<<63), r, overflow)
+	}
+	if _, overflow := MulOverflow[uint64](1<<32, 1<<32); !overflow {
+		t.Fatalf("MulOverflow: expected an overflow")
+	}
+}
+
+func TestSafeConvertExhaustive(t *testing.T) {
+	for x := math.MinInt16; x <= math.MaxInt16; x++ {
+		if y, ok := SafeConvert[int8](int16(x)); ok != (x >= math.MinInt8 && x <= math.MaxInt8) || ok && int(y) != x {
+			t.Fatalf("SafeConvert[int8](%d): got %d %v", x, y, ok)
+		}
+		if y, ok := SafeConvert[uint8](int16(x)); ok != (x >= 0 && x <= math.MaxUint8) || ok && int(y) != x {
+			t.Fatalf("SafeConvert[uint8](%d): got %d %v", x, y, ok)
+		}
+		if y, ok := SafeConvert[uint16](int16(x)); ok != (x >= 0) || ok && int(y) != x {
+			t.Fatalf("SafeConvert[uint16](%d): got %d %v", x, y, ok)
+		}
+	}
+	for x := 0; x <= math.MaxUint16; x++ {
+		if y, ok := SafeConvert[int16](uint16(x)); ok != (x <= math.MaxInt16) || ok && int(y) != x {
+			t.Fatalf("SafeConvert[int16](%d): got %d %v", x, y, ok)
+		}
+		if y, ok := SafeConvert[int64](uint16(x)); !ok || int(y) != x {
+			t.Fatalf("SafeConvert[int64](%d): got %d %v", x, y, ok)
+		}
+	}
+	if _, ok := SafeConvert[int64](uint64(math.MaxUint64)); ok {
+		t.Fatalf("SafeConvert[int64](MaxUint64): expected a failure")
+	}
+}
+
+func TestGCDLCMExhaustive(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			expected := new(big.Int).GCD(nil, nil, big.NewInt(int64(abs(a))), big.NewInt(int64(abs(b)))).Int64()
+			if gcd := GCD(int8(a), int8(b)); int8(expected) != gcd {
+				t.Fatalf("GCD(%d, %d): expected %d got %d", a, b, int8(expected), gcd)
+			}
+
+			expectedLCM := 0
+			if a != 0 && b != 0 {
+				expectedLCM = abs(a) / int(expected) * abs(b)
+			}
+			lcm, overflow := LCM(int8(a), int8(b))
+			if overflow != (expectedLCM > math.MaxInt8) || !overflow && int(lcm) != expectedLCM {
+				t.Fatalf("LCM(%d, %d): expected %d got %d (%v)", a, b, expectedLCM, lcm, overflow)
+			}
+		}
+	}
+	if lcm, overflow := LCM[uint8](16, 12); overflow || lcm != 48 {
+		t.Fatalf("LCM: expected %d got %d (%v)", 48, lcm, overflow)
+	}
+	if _, overflow := LCM[uint8](16, 17); !overflow {
+		t.Fatalf("LCM: expected an overflow")
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestPowMod(t *testing.T) {
+	for base := math.MinInt8; base <= math.MaxInt8; base++ {
+		for exp := 0; exp < 20; exp++ {
+			for _, mod := range []int{1, 2, 7, 100, 127} {
+				expected := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(exp)), big.NewInt(int64(mod))).Int64()
+				if result := PowMod(int8(base), int8(exp), int8(mod)); int64(result) != expected {
+					t.Fatalf("PowMod(%d, %d, %d): expected %d got %d", base, exp, mod, expected, result)
+				}
+			}
+		}
+	}
+
+	// the intermediate products do not fit on 64 bits
+	base, exp, mod := uint64(math.MaxUint64-1), uint64(123456789), uint64(math.MaxUint64-58)
+	expected := new(big.Int).Exp(new(big.Int).SetUint64(base), new(big.Int).SetUint64(exp), new(big.Int).SetUint64(mod))
+	if result := PowMod(base, exp, mod); result != expected.Uint64() {
+		t.Fatalf("PowMod: expected %d got %d", expected.Uint64(), result)
+	}
+}
+
+func TestSqrtLog2Exhaustive(t *testing.T) {
+	for x := 0; x <= math.MaxUint16; x++ {
+		r := int(Sqrt(uint16(x)))
+		if r*r > x || (r+1)*(r+1) <= x {
+			t.Fatalf("Sqrt(%d): got %d", x, r)
+		}
+		if x > 0 {
+			l := Log2(uint16(x))
+			if 1<<l > x || 1<<(l+1) <= x {
+				t.Fatalf("Log2(%d): got %d", x, l)
+			}
+		}
+	}
+
+	for _, x := range []uint64{math.MaxUint64, 1<<64 - 1<<32, (1<<32 - 1) * (1<<32 - 1), (1<<32-1)*(1<<32-1) - 1} {
+		r := new(big.Int).Sqrt(new(big.Int).SetUint64(x)).Uint64()
+		if result := Sqrt(x); result != r {
+			t.Fatalf("Sqrt(%d): expected %d got %d", x, r, result)
+		}
+	}
+	if l := Log2(int64(math.MaxInt64)); l != 62 {
+		t.Fatalf("Log2: expected %d got %d", 62, l)
+	}
+}
+
+func TestAbsClamp(t *testing.T) {
+	if Abs[int8](-5) != 5 || Abs[int8](5) != 5 || Abs[int8](math.MinInt8) != math.MinInt8 {
+		t.Fatalf("Abs: wrong result")
+	}
+	for x := math.MinInt8; x <= math.MaxInt8; x++ {
+		expected := max(-10, min(x, 20))
+		if result := Clamp(int8(x), -10, 20); int(result) != expected {
+			t.Fatalf("Clamp(%d): expected %d got %d", x, expected, result)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Clamp: expected a panic on lo > hi")
+		}
+	}()
+	Clamp(0, 1, 0)
+}
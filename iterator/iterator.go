@@ -0,0 +1,172 @@
+package iterator
+
+import (
+	"iter"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+	"github.com/AlexandreChamard/go-generic/queue"
+	"github.com/AlexandreChamard/go-generic/stack"
+)
+
+// Lazy sequences of the standard iter package: nothing is computed until the sequence is iterated,
+// and the iteration stops as soon as yield returns false
+
+func FromSlice[T any](slice []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, a := range slice {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Stops when the channel is closed
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for a := range ch {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Pop the elements of the queue while iterating, from the front to the back
+func DrainQueue[T any](q queue.Queue[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !q.Empty() {
+			a := q.Front()
+			q.Pop()
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Pop the elements of the stack while iterating, from the top to the bottom
+func DrainStack[T any](s stack.Stack[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !s.Empty() {
+			a := s.Top()
+			s.Pop()
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Pop the elements of the priority queue while iterating, in priority order
+func DrainPriorityQueue[T any](pq priorityqueue.PriorityQueue[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !pq.Empty() {
+			a := pq.Front()
+			pq.Pop()
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+func Map[T, U any](s iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		s(func(a T) bool { return yield(f(a)) })
+	}
+}
+
+func Filter[T any](s iter.Seq[T], pred builtin.Eqf[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(a T) bool { return !pred(a) || yield(a) })
+	}
+}
+
+// The n first elements
+func Take[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		s(func(a T) bool {
+			i++
+			return yield(a) && i < n
+		})
+	}
+}
+
+// All the elements but the n first ones
+func Skip[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		s(func(a T) bool {
+			if i < n {
+				i++
+				return true
+			}
+			return yield(a)
+		})
+	}
+}
+
+// The elements of each sequence, one after the other
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		stopped := false
+		for _, s := range seqs {
+			s(func(a T) bool {
+				stopped = !yield(a)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// Pairs of elements of a and b, stops at the end of the shortest sequence
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		a(func(x A) bool {
+			y, ok := nextB()
+			return ok && yield(x, y)
+		})
+	}
+}
+
+// Pairs of index and element
+func Enumerate[T any](s iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		s(func(a T) bool {
+			ok := yield(i, a)
+			i++
+			return ok
+		})
+	}
+}
+
+func Collect[T any](s iter.Seq[T]) []T {
+	var result []T
+	s(func(a T) bool {
+		result = append(result, a)
+		return true
+	})
+	return result
+}
+
+// Left fold of the sequence
+func Reduce[T, U any](s iter.Seq[T], init U, f func(U, T) U) U {
+	acc := init
+	s(func(a T) bool {
+		acc = f(acc, a)
+		return true
+	})
+	return acc
+}
@@ -0,0 +1,164 @@
+package iterator
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+	"github.com/AlexandreChamard/go-generic/queue"
+	"github.com/AlexandreChamard/go-generic/stack"
+)
+
+// Counts how many elements have been generated to check the laziness
+func naturals(generated *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*generated++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func isEven(i int) bool { return i%2 == 0 }
+
+func TestPipeline(t *testing.T) {
+	generated := 0
+	s := Take(Map(Filter(naturals(&generated), isEven), func(i int) int { return i * i }), 4)
+
+	if generated != 0 {
+		t.Fatalf("the sequence should be lazy, %d elements generated", generated)
+	}
+	result := Collect(s)
+	if expected := []int{0, 4, 16, 36}; !slices.Equal(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+	// 0 to 6 are needed, nothing after
+	if generated != 7 {
+		t.Fatalf("expected %d generated elements got %d", 7, generated)
+	}
+}
+
+func TestRangeOverFunc(t *testing.T) {
+	result := []int{}
+	for i := range Skip(FromSlice([]int{1, 2, 3, 4, 5}), 2) {
+		if i == 5 {
+			break
+		}
+		result = append(result, i)
+	}
+	if expected := []int{3, 4}; !slices.Equal(result, expected) {
+		t.Fatalf("expected %v got %v", expected, result)
+	}
+
+	// interchangeable with the standard library sequences
+	if result := slices.Collect(FromSlice([]int{1, 2})); !slices.Equal(result, []int{1, 2}) {
+		t.Fatalf("expected %v got %v", []int{1, 2}, result)
+	}
+	double := func(a int) int { return a * 2 }
+	if result := Collect(Map(slices.Values([]int{3, 4}), double)); !slices.Equal(result, []int{6, 8}) {
+		t.Fatalf("expected %v got %v", []int{6, 8}, result)
+	}
+}
+
+func TestTakeSkip(t *testing.T) {
+	s := FromSlice([]int{1, 2, 3})
+	tests := []struct {
+		name     string
+		s        iter.Seq[int]
+		expected []int
+	}{
+		{"Take(0)", Take(s, 0), nil},
+		{"Take(2)", Take(s, 2), []int{1, 2}},
+		{"Take(5)", Take(s, 5), []int{1, 2, 3}},
+		{"Skip(0)", Skip(s, 0), []int{1, 2, 3}},
+		{"Skip(2)", Skip(s, 2), []int{3}},
+		{"Skip(5)", Skip(s, 5), nil},
+	}
+	for _, test := range tests {
+		if result := Collect(test.s); !slices.Equal(result, test.expected) {
+			t.Fatalf("%s: expected %v got %v", test.name, test.expected, result)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	s := Chain(FromSlice([]int{1, 2}), FromSlice([]int{}), FromSlice([]int{3}))
+	if result := Collect(s); !slices.Equal(result, []int{1, 2, 3}) {
+		t.Fatalf("expected %v got %v", []int{1, 2, 3}, result)
+	}
+
+	generated := 0
+	s = Chain(FromSlice([]int{1}), naturals(&generated), FromSlice([]int{42}))
+	if result := Collect(Take(s, 3)); !slices.Equal(result, []int{1, 0, 1}) {
+		t.Fatalf("expected %v got %v", []int{1, 0, 1}, result)
+	}
+}
+
+func TestZipEnumerate(t *testing.T) {
+	generated := 0
+	keys, values := []string{}, []int{}
+	for k, v := range Zip(FromSlice([]string{"a", "b", "c"}), naturals(&generated)) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if !slices.Equal(keys, []string{"a", "b", "c"}) || !slices.Equal(values, []int{0, 1, 2}) {
+		t.Fatalf("expected %v %v got %v %v", []string{"a", "b", "c"}, []int{0, 1, 2}, keys, values)
+	}
+
+	for range Zip(naturals(&generated), FromSlice([]int{})) {
+		t.Fatalf("the zip of an empty sequence should be empty")
+	}
+
+	indexes := []int{}
+	for i, v := range Enumerate(FromSlice([]string{"x", "y", "z"})) {
+		if v == "z" {
+			break
+		}
+		indexes = append(indexes, i)
+	}
+	if !slices.Equal(indexes, []int{0, 1}) {
+		t.Fatalf("expected %v got %v", []int{0, 1}, indexes)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	if sum := Reduce(FromSlice([]int{1, 2, 3, 4}), 0, func(acc, i int) int { return acc + i }); sum != 10 {
+		t.Fatalf("expected %d got %d", 10, sum)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	if result := Collect(FromChan(ch)); !slices.Equal(result, []int{1, 2, 3}) {
+		t.Fatalf("expected %v got %v", []int{1, 2, 3}, result)
+	}
+}
+
+func TestContainers(t *testing.T) {
+	q := queue.NewQueue[int]()
+	s := stack.NewStack[int]()
+	pq := priorityqueue.NewPriorityQueue(func(a, b int) bool { return a < b })
+	for _, i := range []int{3, 1, 2} {
+		q.Push(i)
+		s.Push(i)
+		pq.Push(i)
+	}
+
+	if result := Collect(DrainQueue(q)); !slices.Equal(result, []int{3, 1, 2}) || !q.Empty() {
+		t.Fatalf("DrainQueue: expected %v got %v", []int{3, 1, 2}, result)
+	}
+	if result := Collect(DrainStack(s)); !slices.Equal(result, []int{2, 1, 3}) || !s.Empty() {
+		t.Fatalf("DrainStack: expected %v got %v", []int{2, 1, 3}, result)
+	}
+	// only the consumed elements are popped
+	if result := Collect(Take(DrainPriorityQueue(pq), 2)); !slices.Equal(result, []int{1, 2}) || pq.Size() != 1 {
+		t.Fatalf("DrainPriorityQueue: expected %v got %v", []int{1, 2}, result)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	. "github.com/AlexandreChamard/go-generic/algorithm"
-	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
 )
 
 type Cache[Key comparable, Value any] interface {
@@ -81,6 +80,7 @@ type cache[Key comparable, Value any] struct {
 	cacheDuration time.Duration
 	maxSize       int
 	cacheOffset   int
+	flushBuffer   []keyDeleteTuple[Key] // reused by flushKOldest
 
 	runningChan chan bool
 	mutex       sync.RWMutex
@@ -216,24 +216,26 @@ func (this *cache[Key, Value]) flushKOldest(n int) {
 		return
 	}
 
-	queue := priorityqueue.NewPriorityQueue(func(a, b keyDeleteTuple[Key]) bool {
-		return a.deleteAt < b.deleteAt
-	})
-
+	// max-heap of the n oldest items: the front is the newest of them and is replaced by any older item
+	older := func(a, b keyDeleteTuple[Key]) bool { return a.deleteAt < b.deleteAt }
+	heap := this.flushBuffer[:0]
 	for key, value := range this.data {
-		queue.Push(keyDeleteTuple[Key]{
+		heap = append(heap, keyDeleteTuple[Key]{
 			key:      key,
 			deleteAt: value.deleteAt,
 		})
-		if queue.Size() > n {
-			queue.Pop()
+		PushHeap(heap, older)
+		if len(heap) > n {
+			PopHeap(heap, older)
+			heap = heap[:n]
 		}
 	}
 
-	for !queue.Empty() {
-		delete(this.data, queue.Front().key)
-		queue.Pop()
+	for _, item := range heap {
+		delete(this.data, item.key)
 	}
+	clear(heap)
+	this.flushBuffer = heap
 }
 
 func (this *cache[Key, Value]) Flush() {
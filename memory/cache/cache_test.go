@@ -239,6 +239,24 @@ func TestCacheBigExpiration(t *testing.T) {
 	cache.mutex.RUnlock()
 }
 
+func TestFlushKOldest(t *testing.T) {
+	cache := newCache[int, int](NewCacheOptions().NoPurge())
+	now := time.Now().UnixNano()
+	for _, i := range []int{4, 0, 7, 2, 9, 1, 5, 8, 3, 6} {
+		cache.data[i] = mapData[int]{value: i, deleteAt: now + int64(i)}
+	}
+
+	for round, n := range []int{3, 3} {
+		cache.FlushKOldest(n)
+		for i := 0; i < 10; i++ {
+			_, ok := cache.data[i]
+			if expected := i >= 3*(round+1); ok != expected {
+				t.Fatalf("round %d: item %d: expected present=%v got %v", round, i, expected, ok)
+			}
+		}
+	}
+}
+
 var k int
 
 func benchmarkCache(b *testing.B, n int, cacheSize int) {
@@ -0,0 +1,103 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Online summary of a stream of values in O(1) memory
+// Not thread safe: use one accumulator per goroutine and Merge them
+type Accumulator[T builtin.NumberType] interface {
+	Add(T)
+	// Add all the values seen by other
+	Merge(other Accumulator[T])
+
+	Count() int
+	Sum() T
+	Min() (T, bool)
+	Max() (T, bool)
+	Mean() (float64, bool)
+	Variance() (float64, bool)       // population variance
+	SampleVariance() (float64, bool) // unbiased, needs at least 2 values
+	StdDev() (float64, bool)
+}
+
+func NewAccumulator[T builtin.NumberType]() Accumulator[T] {
+	return &accumulator[T]{}
+}
+
+type accumulator[T builtin.NumberType] struct {
+	count    int
+	sum      T
+	min, max T
+	mean     float64
+	m2       float64 // sum of the squared differences from the mean
+}
+
+func (this *accumulator[T]) Add(x T) {
+	if this.count == 0 || x < this.min {
+		this.min = x
+	}
+	if this.count == 0 || x > this.max {
+		this.max = x
+	}
+	this.count++
+	this.sum += x
+
+	delta := float64(x) - this.mean
+	this.mean += delta / float64(this.count)
+	this.m2 += delta * (float64(x) - this.mean)
+}
+
+// Chan et al. parallel variance
+func (this *accumulator[T]) Merge(other Accumulator[T]) {
+	o, ok := other.(*accumulator[T])
+	if !ok || o.count == 0 {
+		return
+	}
+	if this.count == 0 {
+		*this = *o
+		return
+	}
+
+	if o.min < this.min {
+		this.min = o.min
+	}
+	if o.max > this.max {
+		this.max = o.max
+	}
+	count := this.count + o.count
+	delta := o.mean - this.mean
+	this.mean += delta * float64(o.count) / float64(count)
+	this.m2 += o.m2 + delta*delta*float64(this.count)*float64(o.count)/float64(count)
+	this.count = count
+	this.sum += o.sum
+}
+
+func (this *accumulator[T]) Count() int { return this.count }
+func (this *accumulator[T]) Sum() T     { return this.sum }
+
+func (this *accumulator[T]) Min() (T, bool) { return this.min, this.count > 0 }
+func (this *accumulator[T]) Max() (T, bool) { return this.max, this.count > 0 }
+
+func (this *accumulator[T]) Mean() (float64, bool) { return this.mean, this.count > 0 }
+
+func (this *accumulator[T]) Variance() (float64, bool) {
+	if this.count == 0 {
+		return 0, false
+	}
+	return this.m2 / float64(this.count), true
+}
+
+func (this *accumulator[T]) SampleVariance() (float64, bool) {
+	if this.count < 2 {
+		return 0, false
+	}
+	return this.m2 / float64(this.count-1), true
+}
+
+func (this *accumulator[T]) StdDev() (float64, bool) {
+	variance, ok := this.Variance()
+	return math.Sqrt(variance), ok
+}
@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	xs := make([]float64, 1000)
+	for i := range xs {
+		xs[i] = r.NormFloat64()*10 + 50
+	}
+
+	acc := NewAccumulator[float64]()
+	if _, ok := acc.Mean(); ok {
+		t.Fatalf("Mean of an empty accumulator should not be ok")
+	}
+	for _, x := range xs {
+		acc.Add(x)
+	}
+
+	mean, _ := Mean(xs)
+	variance, _ := Variance(xs)
+	sampleVariance, _ := SampleVariance(xs)
+	if acc.Count() != len(xs) {
+		t.Fatalf("Count: expected %d got %d", len(xs), acc.Count())
+	}
+	if !almostEqual(acc.Sum(), Sum(xs)) {
+		t.Fatalf("Sum: expected %v got %v", Sum(xs), acc.Sum())
+	}
+	if m, _ := acc.Mean(); !almostEqual(m, mean) {
+		t.Fatalf("Mean: expected %v got %v", mean, m)
+	}
+	if v, _ := acc.Variance(); !almostEqual(v, variance) {
+		t.Fatalf("Variance: expected %v got %v", variance, v)
+	}
+	if v, _ := acc.SampleVariance(); !almostEqual(v, sampleVariance) {
+		t.Fatalf("SampleVariance: expected %v got %v", sampleVariance, v)
+	}
+}
+
+func TestAccumulatorMerge(t *testing.T) {
+	xs := []int{5, 3, 8, 1, 9, 2, 7}
+
+	all := NewAccumulator[int]()
+	parts := []Accumulator[int]{NewAccumulator[int](), NewAccumulator[int](), NewAccumulator[int]()}
+	for i, x := range xs {
+		all.Add(x)
+		parts[i%2].Add(x) // the third one stays empty
+	}
+
+	merged := NewAccumulator[int]()
+	for _, part := range parts {
+		merged.Merge(part)
+	}
+
+	if merged.Count() != all.Count() || merged.Sum() != all.Sum() {
+		t.Fatalf("expected count %d sum %d got count %d sum %d", all.Count(), all.Sum(), merged.Count(), merged.Sum())
+	}
+	if min, _ := merged.Min(); min != 1 {
+		t.Fatalf("Min: expected %d got %d", 1, min)
+	}
+	if max, _ := merged.Max(); max != 9 {
+		t.Fatalf("Max: expected %d got %d", 9, max)
+	}
+	m1, _ := merged.Mean()
+	m2, _ := all.Mean()
+	v1, _ := merged.Variance()
+	v2, _ := all.Variance()
+	if !almostEqual(m1, m2) || !almostEqual(v1, v2) {
+		t.Fatalf("expected mean %v variance %v got mean %v variance %v", m2, v2, m1, v1)
+	}
+}
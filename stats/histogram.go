@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+type Histogram struct {
+	// Bucket i counts the values in [Edges[i], Edges[i+1]), the last bucket also contains its upper edge
+	Edges  []float64
+	Counts []int
+}
+
+// Split [min(xs), max(xs)] in bins buckets of the same width
+func MakeHistogram[T builtin.NumberType](xs []T, bins int) Histogram {
+	if bins <= 0 {
+		return Histogram{}
+	}
+	min, max, ok := algorithm.MinMax_(xs)
+	if !ok {
+		return Histogram{Edges: make([]float64, bins+1), Counts: make([]int, bins)}
+	}
+
+	low, high := float64(min), float64(max)
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = low + (high-low)*float64(i)/float64(bins)
+	}
+	edges[bins] = high
+	return MakeHistogramEdges(xs, edges)
+}
+
+// edges must be sorted, the values outside [edges[0], edges[len(edges)-1]] are ignored
+func MakeHistogramEdges[T builtin.NumberType](xs []T, edges []float64) Histogram {
+	if len(edges) < 2 {
+		return Histogram{Edges: edges}
+	}
+	histogram := Histogram{
+		Edges:  edges,
+		Counts: make([]int, len(edges)-1),
+	}
+	last := len(edges) - 1
+	for _, x := range xs {
+		value := float64(x)
+		if value < edges[0] || value > edges[last] {
+			continue
+		}
+		bucket := algorithm.UpperBound_(edges, value) - 1
+		if bucket == last {
+			bucket-- // the upper edge belongs to the last bucket
+		}
+		histogram.Counts[bucket]++
+	}
+	return histogram
+}
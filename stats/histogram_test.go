@@ -0,0 +1,39 @@
+package stats
+
+import (
+	"testing"
+)
+
+func TestMakeHistogram(t *testing.T) {
+	histogram := MakeHistogram([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 5)
+	expectedEdges := []float64{0, 2, 4, 6, 8, 10}
+	expectedCounts := []int{2, 2, 2, 2, 3}
+
+	for i := range expectedEdges {
+		if histogram.Edges[i] != expectedEdges[i] {
+			t.Fatalf("Edges: expected %v got %v", expectedEdges, histogram.Edges)
+		}
+	}
+	for i := range expectedCounts {
+		if histogram.Counts[i] != expectedCounts[i] {
+			t.Fatalf("Counts: expected %v got %v", expectedCounts, histogram.Counts)
+		}
+	}
+
+	if histogram := MakeHistogram([]int{}, 3); len(histogram.Counts) != 3 {
+		t.Fatalf("expected %d buckets got %d", 3, len(histogram.Counts))
+	}
+	if histogram := MakeHistogram([]int{7, 7}, 2); histogram.Counts[0]+histogram.Counts[1] != 2 {
+		t.Fatalf("expected all the values in the histogram got %v", histogram.Counts)
+	}
+}
+
+func TestMakeHistogramEdges(t *testing.T) {
+	histogram := MakeHistogramEdges([]float64{-1, 0, 0.5, 1, 9.99, 10, 100, 1000}, []float64{0, 1, 10, 100})
+	expected := []int{2, 2, 2}
+	for i := range expected {
+		if histogram.Counts[i] != expected[i] {
+			t.Fatalf("Counts: expected %v got %v", expected, histogram.Counts)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/AlexandreChamard/go-generic/algorithm"
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// All the functions returning an ok boolean return false on an empty input
+
+func Sum[T builtin.NumberType](xs []T) T {
+	var sum T
+	for _, x := range xs {
+		sum += x
+	}
+	return sum
+}
+
+func Product[T builtin.NumberType](xs []T) T {
+	product := T(1)
+	for _, x := range xs {
+		product *= x
+	}
+	return product
+}
+
+func Mean[T builtin.NumberType](xs []T) (float64, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	// incremental mean, does not overflow on big integers
+	mean := 0.
+	for i, x := range xs {
+		mean += (float64(x) - mean) / float64(i+1)
+	}
+	return mean, true
+}
+
+// Mean of the two middle values for an even number of values, xs is left unchanged
+func Median[T builtin.NumberType](xs []T) (float64, bool) {
+	return Percentile(xs, 50, Midpoint)
+}
+
+// Most frequent value, the smallest one on ties
+func Mode[T builtin.NumberType](xs []T) (T, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	counts := make(map[T]int, len(xs))
+	mode, modeCount := xs[0], 0
+	for _, x := range xs {
+		counts[x]++
+		if count := counts[x]; count > modeCount || (count == modeCount && x < mode) {
+			mode, modeCount = x, count
+		}
+	}
+	return mode, true
+}
+
+// Population variance, computed with Welford's algorithm
+func Variance[T builtin.NumberType](xs []T) (float64, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	_, m2 := welford(xs)
+	return m2 / float64(len(xs)), true
+}
+
+// Unbiased sample variance (divided by n-1), false if there are less than 2 values
+func SampleVariance[T builtin.NumberType](xs []T) (float64, bool) {
+	if len(xs) < 2 {
+		return 0, false
+	}
+	_, m2 := welford(xs)
+	return m2 / float64(len(xs)-1), true
+}
+
+// Population standard deviation
+func StdDev[T builtin.NumberType](xs []T) (float64, bool) {
+	variance, ok := Variance(xs)
+	return math.Sqrt(variance), ok
+}
+
+func SampleStdDev[T builtin.NumberType](xs []T) (float64, bool) {
+	variance, ok := SampleVariance(xs)
+	return math.Sqrt(variance), ok
+}
+
+// Returns the mean and the sum of the squared differences from the mean
+func welford[T builtin.NumberType](xs []T) (mean, m2 float64) {
+	for i, x := range xs {
+		delta := float64(x) - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (float64(x) - mean)
+	}
+	return mean, m2
+}
+
+// How to compute a percentile falling between two values
+type Interpolation int
+
+const (
+	Linear   Interpolation = iota // lower + (higher - lower) * fraction
+	Lower                         // lower value
+	Higher                        // higher value
+	Nearest                       // nearest value, the even index on ties
+	Midpoint                      // (lower + higher) / 2
+)
+
+// p in [0, 100], xs is left unchanged
+func Percentile[T builtin.NumberType](xs []T, p float64, interpolation Interpolation) (float64, bool) {
+	if len(xs) == 0 || math.IsNaN(p) {
+		return 0, false
+	}
+	p = math.Min(math.Max(p, 0), 100)
+
+	rank := float64(len(xs)-1) * p / 100
+	lowIndex := int(math.Floor(rank))
+	highIndex := int(math.Ceil(rank))
+
+	// select the lower value then the smallest one after it, O(n)
+	sorted := append([]T{}, xs...)
+	algorithm.NthElement(sorted, lowIndex, builtin.Less[T])
+	low, high := float64(sorted[lowIndex]), float64(sorted[lowIndex])
+	if highIndex != lowIndex {
+		h, _ := algorithm.MinBy(sorted[highIndex:], builtin.Less[T])
+		high = float64(h)
+	}
+
+	switch interpolation {
+	case Lower:
+		return low, true
+	case Higher:
+		return high, true
+	case Nearest:
+		if math.RoundToEven(rank) == float64(lowIndex) {
+			return low, true
+		}
+		return high, true
+	case Midpoint:
+		return (low + high) / 2, true
+	default:
+		return low + (high-low)*(rank-float64(lowIndex)), true
+	}
+}
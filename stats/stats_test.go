@@ -0,0 +1,129 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+func TestSumProduct(t *testing.T) {
+	if sum := Sum([]int{1, 2, 3, 4}); sum != 10 {
+		t.Fatalf("Sum: expected %d got %d", 10, sum)
+	}
+	if sum := Sum([]float64{}); sum != 0 {
+		t.Fatalf("Sum: expected %v got %v", 0, sum)
+	}
+	if product := Product([]uint8{2, 3, 4}); product != 24 {
+		t.Fatalf("Product: expected %d got %d", 24, product)
+	}
+	if product := Product([]int{}); product != 1 {
+		t.Fatalf("Product: expected %d got %d", 1, product)
+	}
+}
+
+func TestMean(t *testing.T) {
+	if mean, ok := Mean([]int{1, 2, 3, 4}); !ok || mean != 2.5 {
+		t.Fatalf("Mean: expected %v got %v", 2.5, mean)
+	}
+	if _, ok := Mean([]int{}); ok {
+		t.Fatalf("Mean on an empty slice should not be ok")
+	}
+	// the sum of these values overflows an int8
+	if mean, ok := Mean([]int8{100, 100, 100}); !ok || mean != 100 {
+		t.Fatalf("Mean: expected %v got %v", 100, mean)
+	}
+}
+
+func TestMedianMode(t *testing.T) {
+	tests := []struct {
+		xs     []int
+		median float64
+		mode   int
+	}{
+		{[]int{3}, 3, 3},
+		{[]int{3, 1, 2}, 2, 1},
+		{[]int{4, 1, 3, 2}, 2.5, 1},
+		{[]int{5, 1, 5, 2, 2, 5}, 3.5, 5},
+	}
+	for _, test := range tests {
+		input := append([]int{}, test.xs...)
+		if median, ok := Median(input); !ok || median != test.median {
+			t.Fatalf("Median(%v): expected %v got %v", test.xs, test.median, median)
+		}
+		if mode, ok := Mode(input); !ok || mode != test.mode {
+			t.Fatalf("Mode(%v): expected %v got %v", test.xs, test.mode, mode)
+		}
+		for i := range input {
+			if input[i] != test.xs[i] {
+				t.Fatalf("Median(%v): the input has been modified", test.xs)
+			}
+		}
+	}
+	if _, ok := Median([]int{}); ok {
+		t.Fatalf("Median on an empty slice should not be ok")
+	}
+	if _, ok := Mode([]int{}); ok {
+		t.Fatalf("Mode on an empty slice should not be ok")
+	}
+}
+
+func TestVariance(t *testing.T) {
+	xs := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	if variance, ok := Variance(xs); !ok || !almostEqual(variance, 4) {
+		t.Fatalf("Variance: expected %v got %v", 4, variance)
+	}
+	if stdDev, ok := StdDev(xs); !ok || !almostEqual(stdDev, 2) {
+		t.Fatalf("StdDev: expected %v got %v", 2, stdDev)
+	}
+	if variance, ok := SampleVariance(xs); !ok || !almostEqual(variance, 32./7) {
+		t.Fatalf("SampleVariance: expected %v got %v", 32./7, variance)
+	}
+	if stdDev, ok := SampleStdDev(xs); !ok || !almostEqual(stdDev, math.Sqrt(32./7)) {
+		t.Fatalf("SampleStdDev: expected %v got %v", math.Sqrt(32./7), stdDev)
+	}
+	if _, ok := SampleVariance([]int{1}); ok {
+		t.Fatalf("SampleVariance on a single value should not be ok")
+	}
+
+	// a naive sum of squares loses all the precision with such an offset
+	shifted := make([]float64, len(xs))
+	for i := range xs {
+		shifted[i] = xs[i] + 1e9
+	}
+	if variance, ok := Variance(shifted); !ok || math.Abs(variance-4) > 1e-6 {
+		t.Fatalf("Variance: expected %v got %v", 4, variance)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	xs := []int{1, 2, 3, 4}
+	tests := []struct {
+		p             float64
+		interpolation Interpolation
+		expected      float64
+	}{
+		{0, Linear, 1},
+		{100, Linear, 4},
+		{50, Linear, 2.5},
+		{40, Linear, 2.2},
+		{40, Lower, 2},
+		{40, Higher, 3},
+		{40, Nearest, 2},
+		{60, Nearest, 3},
+		{50, Nearest, 3}, // rank 1.5, rounded to the even index 2
+		{40, Midpoint, 2.5},
+		{150, Linear, 4},
+		{-5, Linear, 1},
+	}
+	for _, test := range tests {
+		if result, ok := Percentile(xs, test.p, test.interpolation); !ok || !almostEqual(result, test.expected) {
+			t.Fatalf("Percentile(%v, %v, %d): expected %v got %v", xs, test.p, test.interpolation, test.expected, result)
+		}
+	}
+	if _, ok := Percentile([]int{}, 50, Linear); ok {
+		t.Fatalf("Percentile on an empty slice should not be ok")
+	}
+}
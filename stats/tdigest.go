@@ -0,0 +1,321 @@
+package stats
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/AlexandreChamard/go-generic/builtin"
+)
+
+// Merging t-digest (Dunning): streaming approximation of the quantiles, very accurate on the tails
+// Thread safe, but faster with one digest per goroutine merged at the end
+type TDigest[T builtin.NumberType] interface {
+	Add(T)
+	// Add all the values seen by other, other is left unchanged
+	Merge(other TDigest[T])
+
+	Count() int
+	// q in [0, 1], false if the digest is empty
+	Quantile(q float64) (float64, bool)
+	// Fraction of the values less than or equal to x, false if the digest is empty
+	CDF(x T) (float64, bool)
+
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+}
+
+var ErrInvalidTDigest = errors.New("invalid t-digest encoding")
+
+const (
+	tdigestEncodingVersion = 1
+	tdigestHeaderSize      = 1 + 4*8 + 4
+	defaultCompression     = 100
+	maxCompression         = 1e6 // bigger decoded compressions are rejected, the buffer size would overflow
+)
+
+// compression: bigger is more accurate and uses more memory (about compression centroids), 100 on <=0
+func NewTDigest[T builtin.NumberType](compression float64) TDigest[T] {
+	if compression <= 0 {
+		compression = defaultCompression
+	}
+	return &tdigest[T]{
+		compression: compression,
+		bufferSize:  int(5 * compression),
+	}
+}
+
+func UnmarshalTDigest[T builtin.NumberType](data []byte) (TDigest[T], error) {
+	digest := &tdigest[T]{}
+	if err := digest.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return digest, nil
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+type tdigest[T builtin.NumberType] struct {
+	mutex sync.Mutex
+
+	compression float64
+	centroids   []centroid // sorted by mean
+	buffer      []centroid // values not merged yet
+	bufferSize  int
+
+	count    float64 // total weight
+	min, max float64
+}
+
+func (this *tdigest[T]) Add(x T) {
+	this.mutex.Lock()
+	this.add(centroid{mean: float64(x), weight: 1})
+	this.mutex.Unlock()
+}
+
+func (this *tdigest[T]) add(c centroid) {
+	if this.count == 0 || c.mean < this.min {
+		this.min = c.mean
+	}
+	if this.count == 0 || c.mean > this.max {
+		this.max = c.mean
+	}
+	this.count += c.weight
+	this.buffer = append(this.buffer, c)
+	if len(this.buffer) >= this.bufferSize {
+		this.compress()
+	}
+}
+
+func (this *tdigest[T]) Merge(other TDigest[T]) {
+	o, ok := other.(*tdigest[T])
+	if !ok || o == this {
+		return
+	}
+
+	o.mutex.Lock()
+	o.compress()
+	centroids := append([]centroid{}, o.centroids...)
+	min, max := o.min, o.max
+	o.mutex.Unlock()
+
+	if len(centroids) == 0 {
+		return
+	}
+	this.mutex.Lock()
+	for _, c := range centroids {
+		this.add(c)
+	}
+	// the centroids carry their means, not the extreme values
+	this.min = math.Min(this.min, min)
+	this.max = math.Max(this.max, max)
+	this.mutex.Unlock()
+}
+
+func (this *tdigest[T]) Count() int {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return int(this.count)
+}
+
+// scale function k1: centroids are smaller near the tails
+func (this *tdigest[T]) k(q float64) float64 {
+	return this.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// Merge the buffer into the centroids
+func (this *tdigest[T]) compress() {
+	if len(this.buffer) == 0 {
+		return
+	}
+	all := append(this.centroids, this.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, len(this.centroids)+1)
+	current := all[0]
+	weightBefore := 0. // weight of the centroids before current
+	for _, c := range all[1:] {
+		q0 := weightBefore / this.count
+		q2 := (weightBefore + current.weight + c.weight) / this.count
+		if this.k(q2)-this.k(q0) <= 1 {
+			current.weight += c.weight
+			current.mean += (c.mean - current.mean) * c.weight / current.weight
+		} else {
+			merged = append(merged, current)
+			weightBefore += current.weight
+			current = c
+		}
+	}
+	this.centroids = append(merged, current)
+	this.buffer = this.buffer[:0]
+}
+
+func (this *tdigest[T]) Quantile(q float64) (float64, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.count == 0 || math.IsNaN(q) {
+		return 0, false
+	}
+	this.compress()
+	q = math.Min(math.Max(q, 0), 1)
+	if q == 0 {
+		return this.min, true
+	}
+	if q == 1 {
+		return this.max, true
+	}
+
+	// each centroid is considered centered on its mean: its half weight is before it
+	index := q * this.count
+	first, last := this.centroids[0], this.centroids[len(this.centroids)-1]
+	if index < first.weight/2 {
+		return interpolate(this.min, first.mean, index/(first.weight/2)), true
+	}
+
+	cumulative := first.weight / 2 // position of the current centroid center
+	for i := 0; i+1 < len(this.centroids); i++ {
+		left, right := this.centroids[i], this.centroids[i+1]
+		step := (left.weight + right.weight) / 2
+		if index < cumulative+step {
+			return interpolate(left.mean, right.mean, (index-cumulative)/step), true
+		}
+		cumulative += step
+	}
+
+	return interpolate(last.mean, this.max, (index-cumulative)/(last.weight/2)), true
+}
+
+func (this *tdigest[T]) CDF(value T) (float64, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.count == 0 {
+		return 0, false
+	}
+	this.compress()
+	x := float64(value)
+	if x < this.min {
+		return 0, true
+	}
+	if x >= this.max {
+		return 1, true
+	}
+
+	first, last := this.centroids[0], this.centroids[len(this.centroids)-1]
+	if x < first.mean {
+		return first.weight / 2 * fraction(this.min, first.mean, x) / this.count, true
+	}
+
+	cumulative := first.weight / 2
+	for i := 0; i+1 < len(this.centroids); i++ {
+		left, right := this.centroids[i], this.centroids[i+1]
+		step := (left.weight + right.weight) / 2
+		if x < right.mean {
+			return (cumulative + step*fraction(left.mean, right.mean, x)) / this.count, true
+		}
+		cumulative += step
+	}
+
+	return (cumulative + last.weight/2*fraction(last.mean, this.max, x)) / this.count, true
+}
+
+func interpolate(a, b, t float64) float64 {
+	return a + (b-a)*math.Min(math.Max(t, 0), 1)
+}
+
+// position of x in [a, b] as a fraction
+func fraction(a, b, x float64) float64 {
+	if b <= a {
+		return 1
+	}
+	return (x - a) / (b - a)
+}
+
+// Big endian: version (1 byte), compression, min, max, count (float64),
+// number of centroids (uint32) then the mean and weight (float64) of each centroid
+func (this *tdigest[T]) MarshalBinary() ([]byte, error) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	this.compress()
+	data := make([]byte, tdigestHeaderSize+len(this.centroids)*16)
+	data[0] = tdigestEncodingVersion
+	for i, f := range []float64{this.compression, this.min, this.max, this.count} {
+		binary.BigEndian.PutUint64(data[1+i*8:], math.Float64bits(f))
+	}
+	binary.BigEndian.PutUint32(data[33:], uint32(len(this.centroids)))
+	for i, c := range this.centroids {
+		offset := tdigestHeaderSize + i*16
+		binary.BigEndian.PutUint64(data[offset:], math.Float64bits(c.mean))
+		binary.BigEndian.PutUint64(data[offset+8:], math.Float64bits(c.weight))
+	}
+	return data, nil
+}
+
+// Checks a decoded digest: the NaN values must fail every test, hence the negated comparisons
+func validTDigest(compression, min, max, count float64, centroids []centroid) bool {
+	finite := func(x float64) bool { return !math.IsNaN(x) && !math.IsInf(x, 0) }
+
+	if !(compression > 0 && compression <= maxCompression) || !finite(min) || !finite(max) || !finite(count) {
+		return false
+	}
+	if len(centroids) == 0 {
+		return count == 0
+	}
+	if !(min <= max) || !(min <= centroids[0].mean) || !(centroids[len(centroids)-1].mean <= max) {
+		return false
+	}
+
+	total := 0.
+	for i, c := range centroids {
+		if !finite(c.mean) || !finite(c.weight) || !(c.weight > 0) {
+			return false
+		}
+		if i > 0 && !(centroids[i-1].mean <= c.mean) {
+			return false
+		}
+		total += c.weight
+	}
+	return count > 0 && math.Abs(total-count) <= 1e-9*count
+}
+
+// Replace the content of the digest
+func (this *tdigest[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < tdigestHeaderSize || data[0] != tdigestEncodingVersion {
+		return ErrInvalidTDigest
+	}
+	readFloat := func(offset int) float64 {
+		return math.Float64frombits(binary.BigEndian.Uint64(data[offset:]))
+	}
+
+	compression := readFloat(1)
+	min, max, count := readFloat(9), readFloat(17), readFloat(25)
+	n := int(binary.BigEndian.Uint32(data[33:]))
+	if len(data) != tdigestHeaderSize+n*16 {
+		return ErrInvalidTDigest
+	}
+
+	centroids := make([]centroid, n)
+	for i := range centroids {
+		offset := tdigestHeaderSize + i*16
+		centroids[i] = centroid{mean: readFloat(offset), weight: readFloat(offset + 8)}
+	}
+	if !validTDigest(compression, min, max, count, centroids) {
+		return ErrInvalidTDigest
+	}
+
+	this.mutex.Lock()
+	this.compression = compression
+	this.bufferSize = int(5 * compression)
+	this.min, this.max, this.count = min, max, count
+	this.centroids = centroids
+	this.buffer = nil
+	this.mutex.Unlock()
+	return nil
+}
@@ -0,0 +1,215 @@
+package stats
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func exactQuantile(sorted []float64, q float64) float64 {
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func checkQuantiles(t *testing.T, name string, digest TDigest[float64], sorted []float64) {
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+		result, ok := digest.Quantile(q)
+		if !ok {
+			t.Fatalf("%s: Quantile(%v) should be ok", name, q)
+		}
+		// compare the ranks: the error is relative to the position, not to the value
+		rank := float64(sort.SearchFloat64s(sorted, result)) / float64(len(sorted))
+		if math.Abs(rank-q) > 0.01 {
+			t.Fatalf("%s: Quantile(%v) = %v has the rank %v", name, q, result, rank)
+		}
+	}
+}
+
+func TestTDigest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	digest := NewTDigest[float64](100)
+	if _, ok := digest.Quantile(0.5); ok {
+		t.Fatalf("Quantile of an empty digest should not be ok")
+	}
+
+	values := make([]float64, 100000)
+	for i := range values {
+		values[i] = r.ExpFloat64()
+		digest.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	if digest.Count() != len(values) {
+		t.Fatalf("Count: expected %d got %d", len(values), digest.Count())
+	}
+	checkQuantiles(t, "exponential", digest, values)
+
+	if min, _ := digest.Quantile(0); min != values[0] {
+		t.Fatalf("Quantile(0): expected %v got %v", values[0], min)
+	}
+	if max, _ := digest.Quantile(1); max != values[len(values)-1] {
+		t.Fatalf("Quantile(1): expected %v got %v", values[len(values)-1], max)
+	}
+
+	for _, q := range []float64{0.1, 0.5, 0.9} {
+		x := exactQuantile(values, q)
+		if cdf, ok := digest.CDF(x); !ok || math.Abs(cdf-q) > 0.01 {
+			t.Fatalf("CDF(%v): expected %v got %v", x, q, cdf)
+		}
+	}
+	if cdf, _ := digest.CDF(-1); cdf != 0 {
+		t.Fatalf("CDF below the minimum: expected %v got %v", 0, cdf)
+	}
+	if cdf, _ := digest.CDF(values[len(values)-1]); cdf != 1 {
+		t.Fatalf("CDF of the maximum: expected %v got %v", 1, cdf)
+	}
+}
+
+func TestTDigestSmall(t *testing.T) {
+	digest := NewTDigest[int](0)
+	for _, x := range []int{1, 2, 3, 4, 5} {
+		digest.Add(x)
+	}
+	if median, _ := digest.Quantile(0.5); median != 3 {
+		t.Fatalf("Quantile(0.5): expected %v got %v", 3, median)
+	}
+	if cdf, _ := digest.CDF(3); cdf != 0.5 {
+		t.Fatalf("CDF(3): expected %v got %v", 0.5, cdf)
+	}
+}
+
+func TestTDigestMerge(t *testing.T) {
+	digests := make([]TDigest[float64], 4)
+	values := make([][]float64, len(digests))
+	wg := sync.WaitGroup{}
+	for i := range digests {
+		digests[i] = NewTDigest[float64](100)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(int64(i)))
+			for j := 0; j < 25000; j++ {
+				// each goroutine sees a different part of the distribution
+				x := r.NormFloat64() + float64(i)*3
+				values[i] = append(values[i], x)
+				digests[i].Add(x)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	merged := NewTDigest[float64](100)
+	all := []float64{}
+	for i := range digests {
+		merged.Merge(digests[i])
+		all = append(all, values[i]...)
+	}
+	sort.Float64s(all)
+
+	if merged.Count() != len(all) {
+		t.Fatalf("Count: expected %d got %d", len(all), merged.Count())
+	}
+	checkQuantiles(t, "merged", merged, all)
+	if min, _ := merged.Quantile(0); min != all[0] {
+		t.Fatalf("Quantile(0): expected %v got %v", all[0], min)
+	}
+}
+
+func TestTDigestBinary(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	digest := NewTDigest[float64](50)
+	for i := 0; i < 10000; i++ {
+		digest.Add(r.Float64())
+	}
+
+	data, err := digest.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error %v", err)
+	}
+	decoded, err := UnmarshalTDigest[float64](data)
+	if err != nil {
+		t.Fatalf("UnmarshalTDigest: unexpected error %v", err)
+	}
+	if decoded.Count() != digest.Count() {
+		t.Fatalf("Count: expected %d got %d", digest.Count(), decoded.Count())
+	}
+	for _, q := range []float64{0, 0.01, 0.5, 0.99, 1} {
+		expected, _ := digest.Quantile(q)
+		if result, _ := decoded.Quantile(q); result != expected {
+			t.Fatalf("Quantile(%v): expected %v got %v", q, expected, result)
+		}
+	}
+
+	// the decoded digest can still be fed
+	decoded.Add(2)
+	if max, _ := decoded.Quantile(1); max != 2 {
+		t.Fatalf("Quantile(1): expected %v got %v", 2, max)
+	}
+
+	for _, invalid := range [][]byte{nil, data[:10], data[:len(data)-1], append([]byte{42}, data[1:]...)} {
+		if _, err := UnmarshalTDigest[float64](invalid); err != ErrInvalidTDigest {
+			t.Fatalf("UnmarshalTDigest: expected %v got %v", ErrInvalidTDigest, err)
+		}
+	}
+}
+
+func TestTDigestCorruptedBinary(t *testing.T) {
+	digest := NewTDigest[float64](50)
+	for i := 0; i < 1000; i++ {
+		digest.Add(float64(i))
+	}
+	data, err := digest.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error %v", err)
+	}
+	n := (len(data) - tdigestHeaderSize) / 16
+	if n < 2 {
+		t.Fatalf("expected at least 2 centroids got %d", n)
+	}
+	centroidOffset := func(i int) int { return tdigestHeaderSize + i*16 }
+
+	// set the float at offset in a copy of data
+	corrupt := func(offset int, f float64) []byte {
+		corrupted := append([]byte{}, data...)
+		binary.BigEndian.PutUint64(corrupted[offset:], math.Float64bits(f))
+		return corrupted
+	}
+	firstMean := math.Float64frombits(binary.BigEndian.Uint64(data[centroidOffset(0):]))
+	secondMean := math.Float64frombits(binary.BigEndian.Uint64(data[centroidOffset(1):]))
+
+	tests := map[string][]byte{
+		"NaN compression":      corrupt(1, math.NaN()),
+		"zero compression":     corrupt(1, 0),
+		"huge compression":     corrupt(1, 1e300),
+		"infinite compression": corrupt(1, math.Inf(1)),
+		"NaN min":              corrupt(9, math.NaN()),
+		"min above the means":  corrupt(9, firstMean+1),
+		"NaN max":              corrupt(17, math.NaN()),
+		"max below the means":  corrupt(17, -1),
+		"NaN count":            corrupt(25, math.NaN()),
+		"wrong count":          corrupt(25, 999),
+		"NaN mean":             corrupt(centroidOffset(0), math.NaN()),
+		"unsorted means":       corrupt(centroidOffset(0), secondMean+0.5),
+		"zero weight":          corrupt(centroidOffset(0)+8, 0),
+		"negative weight":      corrupt(centroidOffset(0)+8, -1),
+		"NaN weight":           corrupt(centroidOffset(0)+8, math.NaN()),
+		"infinite weight":      corrupt(centroidOffset(0)+8, math.Inf(1)),
+	}
+	for name, corrupted := range tests {
+		if _, err := UnmarshalTDigest[float64](corrupted); err != ErrInvalidTDigest {
+			t.Fatalf("%s: expected %v got %v", name, ErrInvalidTDigest, err)
+		}
+	}
+
+	// an empty digest must have a zero count
+	empty, _ := NewTDigest[float64](50).MarshalBinary()
+	if _, err := UnmarshalTDigest[float64](empty); err != nil {
+		t.Fatalf("empty digest: unexpected error %v", err)
+	}
+	binary.BigEndian.PutUint64(empty[25:], math.Float64bits(3))
+	if _, err := UnmarshalTDigest[float64](empty); err != ErrInvalidTDigest {
+		t.Fatalf("empty digest with a count: expected %v got %v", ErrInvalidTDigest, err)
+	}
+}
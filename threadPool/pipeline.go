@@ -0,0 +1,337 @@
+package threadpool
+
+import (
+	"context"
+	"sync"
+
+	priorityqueue "github.com/AlexandreChamard/go-generic/priorityQueue"
+)
+
+// A stage transforms one value; a non nil error stops the whole pipeline
+type Stage[In, Out any] func(In) (Out, error)
+
+type PipelineConfig struct {
+	Ordered bool // output the values in the order of the input
+	// Ordered only: maximum number of inputs read but not output yet, which bounds the results
+	// waiting for a slower previous one (sum of the PoolSize and BufferSize of the stages on <=0)
+	MaxPending int
+}
+
+type StageConfig struct {
+	PoolSize   int // maximum number of parallel executions of the stage (1 on <=0)
+	BufferSize int // number of results waiting for the next stage (unbuffered on <=0)
+}
+
+type Pipeline[In, Out any] interface {
+	// Feed the input into the stages until it is closed, the context is cancelled or a stage fails
+	Run(ctx context.Context, input <-chan In) PipelineResult[Out]
+	// Run the pipeline over a slice and collect the results
+	RunSlice(ctx context.Context, input []In) ([]Out, error)
+
+	config() PipelineConfig
+	capacity() int // number of values the stages can hold at once
+	runStages(run *pipelineRun, input <-chan item[In]) <-chan item[Out]
+}
+
+type PipelineResult[Out any] interface {
+	// Closed once every stage has stopped
+	Output() <-chan Out
+	// Discard the remaining outputs and return the first error: a stage error, or the context error
+	// if the cancellation stopped the run before all the values were output
+	Wait() error
+}
+
+// Pipeline with a single stage, use AddStage to chain the following ones
+func NewPipeline[In, Out any](config PipelineConfig, stageConfig StageConfig, stage Stage[In, Out]) Pipeline[In, Out] {
+	return &pipeline[In, Out]{
+		conf: config,
+		cap:  stageConfig.capacity(),
+		stages: func(run *pipelineRun, input <-chan item[In]) <-chan item[Out] {
+			return runStage(run, stageConfig, stage, input)
+		},
+	}
+}
+
+// Returns a new pipeline, the given one is left unchanged and can still be used
+func AddStage[In, Mid, Out any](p Pipeline[In, Mid], stageConfig StageConfig, stage Stage[Mid, Out]) Pipeline[In, Out] {
+	return &pipeline[In, Out]{
+		conf: p.config(),
+		cap:  p.capacity() + stageConfig.capacity(),
+		stages: func(run *pipelineRun, input <-chan item[In]) <-chan item[Out] {
+			return runStage(run, stageConfig, stage, p.runStages(run, input))
+		},
+	}
+}
+
+func (this StageConfig) capacity() int {
+	return max(this.PoolSize, 1) + max(this.BufferSize, 0)
+}
+
+type item[T any] struct {
+	seq   uint64 // position in the input
+	value T
+}
+
+type pipeline[In, Out any] struct {
+	conf   PipelineConfig
+	cap    int
+	stages func(*pipelineRun, <-chan item[In]) <-chan item[Out]
+}
+
+func (this *pipeline[In, Out]) config() PipelineConfig { return this.conf }
+func (this *pipeline[In, Out]) capacity() int          { return this.cap }
+
+func (this *pipeline[In, Out]) runStages(run *pipelineRun, input <-chan item[In]) <-chan item[Out] {
+	return this.stages(run, input)
+}
+
+func (this *pipeline[In, Out]) Run(ctx context.Context, input <-chan In) PipelineResult[Out] {
+	run := newPipelineRun(ctx)
+
+	// ordered: a slot is taken for each input and released when its result is output in order
+	var pending chan struct{}
+	if this.conf.Ordered {
+		maxPending := this.conf.MaxPending
+		if maxPending <= 0 {
+			maxPending = this.cap
+		}
+		pending = make(chan struct{}, maxPending)
+	}
+
+	source := make(chan item[In])
+	go func() {
+		defer close(source)
+		for seq := uint64(0); ; seq++ {
+			if pending != nil {
+				select {
+				case pending <- struct{}{}:
+				case <-run.ctx.Done():
+					run.interrupted()
+					return
+				}
+			}
+			select {
+			case <-run.ctx.Done():
+				run.interrupted()
+				return
+			case value, ok := <-input:
+				if !ok {
+					return
+				}
+				select {
+				case source <- item[In]{seq: seq, value: value}:
+				case <-run.ctx.Done():
+					run.interrupted()
+					return
+				}
+			}
+		}
+	}()
+
+	result := &pipelineResult[Out]{
+		run:    run,
+		output: make(chan Out),
+	}
+	go func() {
+		stages := this.runStages(run, source)
+		if this.conf.Ordered {
+			result.emitOrdered(stages, pending)
+		} else {
+			result.emit(stages)
+		}
+		close(result.output)
+		run.cancel() // release the context resources
+	}()
+	return result
+}
+
+func (this *pipeline[In, Out]) RunSlice(ctx context.Context, input []In) ([]Out, error) {
+	// a stage error stops the reading of inputChan, cancelling ctx on return releases the feeder
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	inputChan := make(chan In)
+	go func() {
+		defer close(inputChan)
+		for _, value := range input {
+			select {
+			case inputChan <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	result := this.Run(ctx, inputChan)
+	outputs := make([]Out, 0, len(input))
+	for value := range result.Output() {
+		outputs = append(outputs, value)
+	}
+	if err := result.Wait(); err != nil {
+		return nil, err
+	}
+	// the feeding may have been stopped before the pipeline noticed the cancellation
+	if len(outputs) != len(input) {
+		return nil, ctx.Err()
+	}
+	return outputs, nil
+}
+
+// shared by all the stages of a single Run
+type pipelineRun struct {
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	once sync.Once
+	err  error
+
+	mutex     sync.Mutex
+	cancelled error // parent context error, only set if it made the run drop values
+}
+
+func newPipelineRun(parent context.Context) *pipelineRun {
+	ctx, cancel := context.WithCancel(parent)
+	return &pipelineRun{
+		parent: parent,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// only the first error is kept
+func (this *pipelineRun) fail(err error) {
+	this.once.Do(func() {
+		this.err = err
+		this.cancel()
+	})
+}
+
+// Called when a value is dropped because of a cancellation
+func (this *pipelineRun) interrupted() {
+	err := this.parent.Err()
+	if err == nil {
+		// cancelled by a stage error, already in err
+		return
+	}
+	this.mutex.Lock()
+	if this.cancelled == nil {
+		this.cancelled = err
+	}
+	this.mutex.Unlock()
+}
+
+func runStage[In, Out any](run *pipelineRun, config StageConfig, stage Stage[In, Out], input <-chan item[In]) <-chan item[Out] {
+	poolSize := config.PoolSize
+	if poolSize <= 0 {
+		poolSize = 1
+	}
+	bufferSize := config.BufferSize
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	output := make(chan item[Out], bufferSize)
+	tp := NewThreadPool(ThreadPoolConfig{PoolSize: poolSize})
+	// a token is held from the submission of a task until its result is sent to the next stage
+	tokens := make(chan struct{}, poolSize)
+
+	go func() {
+		for in := range input {
+			// keep draining the input after a cancellation so the previous stage can stop
+			if run.ctx.Err() != nil {
+				run.interrupted()
+				continue
+			}
+			select {
+			case tokens <- struct{}{}:
+			case <-run.ctx.Done():
+				run.interrupted()
+				continue
+			}
+			in := in
+			tp.Submit(func() {
+				defer func() { <-tokens }()
+				if run.ctx.Err() != nil {
+					run.interrupted()
+					return
+				}
+				out, err := stage(in.value)
+				if err != nil {
+					run.fail(err)
+					return
+				}
+				select {
+				case output <- item[Out]{seq: in.seq, value: out}:
+				case <-run.ctx.Done():
+					run.interrupted()
+				}
+			})
+		}
+		tp.Stop()
+		tp.Wait()
+		close(output)
+	}()
+	return output
+}
+
+type pipelineResult[Out any] struct {
+	run    *pipelineRun
+	output chan Out
+}
+
+func (this *pipelineResult[Out]) Output() <-chan Out { return this.output }
+
+func (this *pipelineResult[Out]) Wait() error {
+	for range this.output {
+	}
+	if this.run.err != nil {
+		return this.run.err
+	}
+	this.run.mutex.Lock()
+	defer this.run.mutex.Unlock()
+	return this.run.cancelled
+}
+
+func (this *pipelineResult[Out]) send(value Out) bool {
+	select {
+	case this.output <- value:
+		return true
+	case <-this.run.ctx.Done():
+		return false
+	}
+}
+
+func (this *pipelineResult[Out]) emit(input <-chan item[Out]) {
+	for in := range input {
+		if this.run.ctx.Err() != nil || !this.send(in.value) {
+			this.run.interrupted()
+		}
+	}
+}
+
+// results are kept in a heap until all the previous ones have been sent
+// the heap can not grow over the size of pending, a slot is released for each value sent
+func (this *pipelineResult[Out]) emitOrdered(input <-chan item[Out], pending chan struct{}) {
+	waiting := priorityqueue.NewPriorityQueue(func(a, b item[Out]) bool {
+		return a.seq < b.seq
+	})
+	next := uint64(0)
+
+	for in := range input {
+		if this.run.ctx.Err() != nil {
+			this.run.interrupted()
+			continue
+		}
+		waiting.Push(in)
+		for !waiting.Empty() && waiting.Front().seq == next {
+			if !this.send(waiting.Front().value) {
+				this.run.interrupted()
+				break
+			}
+			waiting.Pop()
+			next++
+			<-pending
+		}
+	}
+}
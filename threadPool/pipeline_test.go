@@ -0,0 +1,230 @@
+package threadpool
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPipelineOrdered(t *testing.T) {
+	p := NewPipeline(PipelineConfig{Ordered: true}, StageConfig{PoolSize: 8, BufferSize: 4},
+		func(i int) (int, error) {
+			time.Sleep(time.Duration(i%7) * time.Millisecond)
+			return i * 2, nil
+		})
+	p2 := AddStage(p, StageConfig{PoolSize: 3}, func(i int) (string, error) {
+		return strconv.Itoa(i), nil
+	})
+
+	input := make([]int, 200)
+	for i := range input {
+		input[i] = i
+	}
+
+	output, err := p2.RunSlice(context.Background(), input)
+	if err != nil {
+		t.Fatalf("RunSlice: unexpected error %v", err)
+	}
+	if len(output) != len(input) {
+		t.Fatalf("RunSlice: expected %d outputs got %d", len(input), len(output))
+	}
+	for i, s := range output {
+		if s != strconv.Itoa(i*2) {
+			t.Fatalf("%d: expected %s got %s", i, strconv.Itoa(i*2), s)
+		}
+	}
+}
+
+func TestPipelineOrderedBounded(t *testing.T) {
+	release := make(chan struct{})
+	processed := atomic.Int32{}
+	p := NewPipeline(PipelineConfig{Ordered: true, MaxPending: 10}, StageConfig{PoolSize: 4}, func(i int) (int, error) {
+		// the first value is slow, the next ones wait for it in the reorder buffer
+		if i == 0 {
+			<-release
+		}
+		processed.Add(1)
+		return i, nil
+	})
+
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	type result struct {
+		output []int
+		err    error
+	}
+	done := make(chan result)
+	go func() {
+		output, err := p.RunSlice(context.Background(), input)
+		done <- result{output, err}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if n := processed.Load(); n > 9 {
+		t.Fatalf("expected at most %d values processed ahead of the first one got %d", 9, n)
+	}
+	close(release)
+
+	res := <-done
+	if res.err != nil {
+		t.Fatalf("RunSlice: unexpected error %v", res.err)
+	}
+	if len(res.output) != len(input) {
+		t.Fatalf("expected %d outputs got %d", len(input), len(res.output))
+	}
+	for i, v := range res.output {
+		if v != i {
+			t.Fatalf("%d: expected %d got %d", i, i, v)
+		}
+	}
+}
+
+func TestPipelineUnordered(t *testing.T) {
+	p := NewPipeline(PipelineConfig{}, StageConfig{PoolSize: 4}, func(i int) (int, error) {
+		return i + 1, nil
+	})
+
+	input := make(chan int)
+	go func() {
+		for i := 0; i < 100; i++ {
+			input <- i
+		}
+		close(input)
+	}()
+
+	result := p.Run(context.Background(), input)
+	output := []int{}
+	for i := range result.Output() {
+		output = append(output, i)
+	}
+	if err := result.Wait(); err != nil {
+		t.Fatalf("Wait: unexpected error %v", err)
+	}
+
+	sort.Ints(output)
+	if len(output) != 100 {
+		t.Fatalf("expected %d outputs got %d", 100, len(output))
+	}
+	for i, v := range output {
+		if v != i+1 {
+			t.Fatalf("%d: expected %d got %d", i, i+1, v)
+		}
+	}
+}
+
+func TestPipelineError(t *testing.T) {
+	errStage := errors.New("stage error")
+
+	p := NewPipeline(PipelineConfig{Ordered: true}, StageConfig{PoolSize: 2}, func(i int) (int, error) {
+		return i, nil
+	})
+	p2 := AddStage(p, StageConfig{PoolSize: 2}, func(i int) (int, error) {
+		if i == 10 {
+			return 0, errStage
+		}
+		return i, nil
+	})
+
+	// the input is never closed, the error must stop the pipeline anyway
+	input := make(chan int)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case input <- i:
+			case <-time.After(time.Second):
+				return
+			}
+		}
+	}()
+
+	result := p2.Run(context.Background(), input)
+	if err := result.Wait(); err != errStage {
+		t.Fatalf("Wait: expected %v got %v", errStage, err)
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	p := NewPipeline(PipelineConfig{}, StageConfig{PoolSize: 2}, func(i int) (int, error) {
+		return i, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	input := make(chan int)
+	result := p.Run(ctx, input)
+
+	input <- 1
+	if v := <-result.Output(); v != 1 {
+		t.Fatalf("expected %d got %d", 1, v)
+	}
+	cancel()
+
+	if err := result.Wait(); err != context.Canceled {
+		t.Fatalf("Wait: expected %v got %v", context.Canceled, err)
+	}
+}
+
+func TestPipelineCancelAfterCompletion(t *testing.T) {
+	p := NewPipeline(PipelineConfig{Ordered: true}, StageConfig{PoolSize: 2}, func(i int) (int, error) {
+		return i, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	input := make(chan int)
+	go func() {
+		for i := 0; i < 10; i++ {
+			input <- i
+		}
+		close(input)
+	}()
+
+	result := p.Run(ctx, input)
+	count := 0
+	for range result.Output() {
+		count++
+	}
+	// every value has been output, the cancellation did not cut the run short
+	cancel()
+	if err := result.Wait(); err != nil {
+		t.Fatalf("Wait: expected no error got %v", err)
+	}
+	if count != 10 {
+		t.Fatalf("expected %d outputs got %d", 10, count)
+	}
+}
+
+func TestPipelineRunSliceErrorNoLeak(t *testing.T) {
+	errStage := errors.New("stage error")
+	p := NewPipeline(PipelineConfig{}, StageConfig{PoolSize: 2}, func(i int) (int, error) {
+		if i == 3 {
+			return 0, errStage
+		}
+		return i, nil
+	})
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 50; i++ {
+		if _, err := p.RunSlice(context.Background(), input); err != errStage {
+			t.Fatalf("RunSlice: expected %v got %v", errStage, err)
+		}
+	}
+
+	// the goroutines of the last runs may still be exiting
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Fatalf("expected at most %d goroutines after the failed runs got %d", before, after)
+	}
+}
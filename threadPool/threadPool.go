@@ -21,6 +21,8 @@ type ThreadPool interface {
 	ForceStop()
 	// Wait for all processes to complete
 	Wait()
+	// False once stopped: the submitted tasks are dropped
+	Running() bool
 }
 
 type ThreadPoolConfig struct {
@@ -101,7 +103,6 @@ func (this *threadPool) Stop() {
 	close(this.taskChan)
 	this.mutex.Unlock()
 
-	this.stoppedChan = make(chan bool)
 	this.log(fmt.Sprintf("[threadPool.Stop] END"))
 }
 
@@ -122,10 +123,6 @@ func (this *threadPool) Running() bool {
 	return this.state == State_RUNNING
 }
 
-func (this *threadPool) runningNotSafe() bool {
-	return this.state == State_RUNNING
-}
-
 func makeAndStartThreadPool(config ThreadPoolConfig) *threadPool {
 	pool := &threadPool{
 		poolSize: config.PoolSize,
@@ -152,7 +149,7 @@ func makeAndStartThreadPool(config ThreadPoolConfig) *threadPool {
 
 	go func(this *threadPool) {
 	thread_pool_loop:
-		for this.runningNotSafe() {
+		for this.Running() {
 
 			this.log(fmt.Sprintf("thread pool: wait for action"))
 
@@ -217,7 +214,9 @@ func makeAndStartThreadPool(config ThreadPoolConfig) *threadPool {
 		}
 
 		this.log(fmt.Sprintf("close worker chan"))
+		this.mutex.Lock()
 		this.state = State_STOPPED
+		this.mutex.Unlock()
 		close(this.workerChan)
 
 		// Wait for all workers are closed